@@ -9,13 +9,28 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"tournaments-core/internal/auth"
 	"tournaments-core/internal/config"
 	_grpc "tournaments-core/internal/delivery/grpc"
+	"tournaments-core/internal/domain/models"
 	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/outbox"
 	"tournaments-core/internal/repository/postgresql"
+	"tournaments-core/internal/storage"
+	"tournaments-core/internal/usecase"
+	"tournaments-core/internal/webhooks"
 )
 
+// eventHistory is how many of the latest events are kept in memory for
+// watchers that resume after a reconnect, older ones are read from the outbox.
+const eventHistory = 10000
+
+// defaultStorageDir keeps result attachments next to the service when neither
+// GRPC_STORAGE nor STORAGE_DIR is set.
+const defaultStorageDir = "attachments"
+
 var (
 	ctx, cancel = context.WithCancel(context.Background())
 )
@@ -35,17 +50,89 @@ func main() {
 
 	gamesRepository, err := postgresql.NewGamesRepository(dbUrl)
 	if err != nil {
-		log.Fatalf("[POSTGRES]: Error while initializing repository: %w", err)
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
 	}
 
 	resultRepository, err := postgresql.NewResultsRepository(dbUrl)
 	if err != nil {
-		log.Fatalf("[POSTGRES]: Error while initializing repository: %w", err)
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	tournamentsRepository, err := postgresql.NewTournamentsRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	participantsRepository, err := postgresql.NewParticipantsRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	gameTypesRepository, err := postgresql.NewGameTypesRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	outboxRepository, err := postgresql.NewOutboxRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	webhooksRepository, err := postgresql.NewWebhooksRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	grantsRepository, err := postgresql.NewTournamentGrantsRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	reviewsRepository, err := postgresql.NewResultReviewsRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	attachmentsRepository, err := postgresql.NewAttachmentsRepository(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	transactor, err := postgresql.NewTransactor(dbUrl)
+	if err != nil {
+		log.Fatalf("[POSTGRES]: Error while initializing repository: %v", err)
+	}
+
+	blobs, err := blobStore(cfg)
+	if err != nil {
+		log.Fatalf("[STORAGE]: %v", err)
+	}
+
+	sinks, err := outboxSinks(ctx, cfg.OutboxConfig)
+	if err != nil {
+		log.Fatalf("[OUTBOX]: %v", err)
+	}
+	// Webhook subscriptions are always fed from the outbox.
+	sinks = append(sinks, webhooks.NewDispatcher(webhooksRepository))
+	go outbox.NewRelay(outboxRepository, sinks...).Run(ctx)
+	go webhooks.NewSender(webhooksRepository, nil).Run(ctx)
+
+	// Watchers are fed from the outbox, so they see every committed change
+	// numbered by its outbox sequence.
+	events, err := usecase.NewEventBroker(ctx, outboxRepository, eventHistory)
+	if err != nil {
+		log.Fatalf("[EVENTS]: %v", err)
+	}
+	go events.Run(ctx)
+
+	verifier, err := authVerifier(cfg)
+	if err != nil {
+		log.Fatalf("[AUTH]: %v", err)
 	}
 
 	// TODO: logger
 
-	go RunGrpcServer(cfg, &gamesRepository, &resultRepository)
+	go RunGrpcServer(cfg, &gamesRepository, &resultRepository, &tournamentsRepository, &participantsRepository, &gameTypesRepository, &webhooksRepository, &grantsRepository, &reviewsRepository, &attachmentsRepository, transactor, blobs, events, verifier)
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
@@ -57,10 +144,19 @@ func main() {
 	}
 }
 
-func RunGrpcServer(config *config.Config, games_rep *repository.GamesRepository, res_rep *repository.ResultsRepository) {
-	grpcServer := grpc.NewServer()
-	_grpc.NewGamesGrpcServer(grpcServer, games_rep)
-	_grpc.NewResultsGrpcServer(grpcServer, res_rep)
+func RunGrpcServer(config *config.Config, games_rep *repository.GamesRepository, res_rep *repository.ResultsRepository, tour_rep *repository.TournamentsRepository, part_rep *repository.ParticipantsRepository, types_rep *repository.GameTypesRepository, webhooks_rep *repository.WebhooksRepository, grants_rep *repository.TournamentGrantsRepository, reviews_rep *repository.ResultReviewsRepository, attachments_rep *repository.AttachmentsRepository, transactor repository.Transactor, blobs repository.BlobStore, events *usecase.EventBroker, verifier auth.Verifier) {
+	unaryAuth, streamAuth := _grpc.AuthInterceptors(verifier)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryAuth),
+		grpc.StreamInterceptor(streamAuth),
+	)
+	_grpc.NewGamesGrpcServer(grpcServer, games_rep, part_rep, types_rep, grants_rep, events)
+	_grpc.NewResultsGrpcServer(grpcServer, res_rep, games_rep, tour_rep, grants_rep, reviews_rep, part_rep, transactor, attachments_rep, blobs, events)
+	_grpc.NewTournamentsGrpcServer(grpcServer, tour_rep, games_rep, res_rep, grants_rep, transactor, events)
+	_grpc.NewStandingsGrpcServer(grpcServer, tour_rep, games_rep, res_rep)
+	_grpc.NewParticipantsGrpcServer(grpcServer, part_rep, grants_rep)
+	_grpc.NewGameTypesGrpcServer(grpcServer, types_rep)
+	_grpc.NewWebhooksGrpcServer(grpcServer, webhooks_rep, grants_rep)
 	reflection.Register(grpcServer)
 
 	lis, err := net.Listen("tcp", config.GrpcConfig.Port)
@@ -73,3 +169,99 @@ func RunGrpcServer(config *config.Config, games_rep *repository.GamesRepository,
 		log.Fatal(grpcServer.Serve(lis))
 	}()
 }
+
+// outboxSinks builds the sinks named in the config, events go to the log when
+// none are named.
+func outboxSinks(ctx context.Context, config config.OutboxConfig) ([]outbox.Sink, error) {
+	names := config.Sinks
+	if names == "" {
+		names = "log"
+	}
+
+	var sinks []outbox.Sink
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case "log":
+			sinks = append(sinks, outbox.LogSink{})
+		case "webhook":
+			if config.WebhookURL == "" {
+				return nil, fmt.Errorf("webhook sink needs OUTBOX_WEBHOOK_URL")
+			}
+			sinks = append(sinks, outbox.NewWebhookSink(config.WebhookURL))
+		case "channel":
+			sink := outbox.NewChannelSink(channelBuffer)
+			go consumeEvents(ctx, sink.Events())
+			sinks = append(sinks, sink)
+		default:
+			return nil, fmt.Errorf("unknown sink %q", name)
+		}
+	}
+
+	return sinks, nil
+}
+
+// channelBuffer is how many events the channel sink holds before the relay
+// waits for its consumer.
+const channelBuffer = 100
+
+// consumeEvents takes the events of the channel sink inside the process until
+// ctx is done.
+func consumeEvents(ctx context.Context, events <-chan models.Event) {
+	for {
+		select {
+		case e := <-events:
+			log.Printf("[OUTBOX]: consumed #%d %s %s %s", e.Sequence, e.Entity, e.EntityID, e.Type)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// authVerifier checks tokens locally when a signing key is configured and
+// asks the auth service otherwise.
+func authVerifier(cfg *config.Config) (auth.Verifier, error) {
+	var options []auth.JWTOption
+	if cfg.AuthConfig.JWTSecret != "" {
+		options = append(options, auth.WithHS256([]byte(cfg.AuthConfig.JWTSecret)))
+	}
+	if cfg.AuthConfig.JWTPublicKeyFile != "" {
+		pemKey, err := os.ReadFile(cfg.AuthConfig.JWTPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		publicKey, err := auth.ParseRSAPublicKey(pemKey)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, auth.WithRS256(publicKey))
+	}
+
+	if len(options) == 0 {
+		if cfg.GrpcConfig.Auth == "" {
+			return nil, fmt.Errorf("set AUTH_JWT_SECRET, AUTH_JWT_PUBLIC_KEY_FILE or GRPC_AUTH")
+		}
+		return auth.NewRemoteVerifier(cfg.GrpcConfig.Auth)
+	}
+
+	if cfg.AuthConfig.Issuer != "" {
+		options = append(options, auth.WithIssuer(cfg.AuthConfig.Issuer))
+	}
+	if cfg.AuthConfig.Audience != "" {
+		options = append(options, auth.WithAudience(cfg.AuthConfig.Audience))
+	}
+	return auth.NewJWTVerifier(options...)
+}
+
+// blobStore keeps result attachments in the storage service when one is
+// configured, otherwise in a local directory.
+func blobStore(cfg *config.Config) (repository.BlobStore, error) {
+	if cfg.GrpcConfig.Storage != "" {
+		return storage.NewRemoteStore(cfg.GrpcConfig.Storage)
+	}
+	dir := cfg.StorageConfig.Dir
+	if dir == "" {
+		dir = defaultStorageDir
+		log.Printf("[STORAGE]: neither GRPC_STORAGE nor STORAGE_DIR is set, keeping attachments in %q", dir)
+	}
+	return storage.NewLocalStore(dir)
+}
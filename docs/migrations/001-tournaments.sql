@@ -0,0 +1,19 @@
+--liquibase formatted sql
+
+--changeset game-creator:001-tournaments
+CREATE TABLE game_creator.tournaments
+(
+    tournament_id UUID PRIMARY KEY,
+    name          VARCHAR(255) NOT NULL,
+    game_type_id  UUID         NOT NULL REFERENCES game_creator.game_types (game_type_id),
+    format        VARCHAR(32)  NOT NULL,
+    starts_at     TIMESTAMPTZ  NOT NULL,
+    ends_at       TIMESTAMPTZ  NOT NULL,
+    status        VARCHAR(32)  NOT NULL DEFAULT 'draft'
+);
+
+ALTER TABLE game_creator.games
+    ADD COLUMN tournament_id UUID REFERENCES game_creator.tournaments (tournament_id) ON DELETE CASCADE,
+    ADD COLUMN round         INT NOT NULL DEFAULT 0;
+--rollback ALTER TABLE game_creator.games DROP COLUMN round, DROP COLUMN tournament_id;
+--rollback DROP TABLE game_creator.tournaments;
@@ -0,0 +1,18 @@
+--liquibase formatted sql
+
+--changeset game-creator:002-bracket-games
+ALTER TABLE game_creator.games
+    ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+CREATE TABLE game_creator.game_participants
+(
+    game_id        UUID NOT NULL REFERENCES game_creator.games (game_id) ON DELETE CASCADE,
+    slot           INT  NOT NULL,
+    participant_id UUID NOT NULL,
+    PRIMARY KEY (game_id, slot)
+);
+
+CREATE INDEX games_tournament_round_idx ON game_creator.games (tournament_id, round, position);
+--rollback DROP INDEX game_creator.games_tournament_round_idx;
+--rollback DROP TABLE game_creator.game_participants;
+--rollback ALTER TABLE game_creator.games DROP COLUMN position;
@@ -0,0 +1,16 @@
+--liquibase formatted sql
+
+--changeset game-creator:003-double-elimination
+ALTER TABLE game_creator.tournaments
+    ADD COLUMN grand_final_reset BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE game_creator.games
+    ADD COLUMN bracket             VARCHAR(16) NOT NULL DEFAULT '',
+    ADD COLUMN winner_next_game_id UUID REFERENCES game_creator.games (game_id) ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED,
+    ADD COLUMN winner_next_slot    INT         NOT NULL DEFAULT 0,
+    ADD COLUMN loser_next_game_id  UUID REFERENCES game_creator.games (game_id) ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED,
+    ADD COLUMN loser_next_slot     INT         NOT NULL DEFAULT 0;
+
+UPDATE game_creator.games SET bracket = 'winners' WHERE tournament_id IS NOT NULL;
+--rollback ALTER TABLE game_creator.games DROP COLUMN loser_next_slot, DROP COLUMN loser_next_game_id, DROP COLUMN winner_next_slot, DROP COLUMN winner_next_game_id, DROP COLUMN bracket;
+--rollback ALTER TABLE game_creator.tournaments DROP COLUMN grand_final_reset;
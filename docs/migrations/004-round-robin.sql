@@ -0,0 +1,13 @@
+--liquibase formatted sql
+
+--changeset game-creator:004-round-robin
+ALTER TABLE game_creator.tournaments
+    ADD COLUMN slot_length_seconds BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN legs                INT    NOT NULL DEFAULT 1,
+    ADD COLUMN groups              INT    NOT NULL DEFAULT 0,
+    ADD COLUMN group_advance       INT    NOT NULL DEFAULT 0;
+
+ALTER TABLE game_creator.games
+    ADD COLUMN group_number INT NOT NULL DEFAULT 0;
+--rollback ALTER TABLE game_creator.games DROP COLUMN group_number;
+--rollback ALTER TABLE game_creator.tournaments DROP COLUMN group_advance, DROP COLUMN groups, DROP COLUMN legs, DROP COLUMN slot_length_seconds;
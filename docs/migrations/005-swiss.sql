@@ -0,0 +1,6 @@
+--liquibase formatted sql
+
+--changeset game-creator:005-swiss
+ALTER TABLE game_creator.tournaments
+    ADD COLUMN swiss_rounds INT NOT NULL DEFAULT 0;
+--rollback ALTER TABLE game_creator.tournaments DROP COLUMN swiss_rounds;
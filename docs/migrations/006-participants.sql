@@ -0,0 +1,32 @@
+--liquibase formatted sql
+
+--changeset game-creator:006-participants
+CREATE TABLE game_creator.participants
+(
+    participant_id UUID PRIMARY KEY,
+    name           VARCHAR(255) NOT NULL,
+    kind           VARCHAR(16)  NOT NULL DEFAULT 'player'
+);
+
+CREATE TABLE game_creator.team_members
+(
+    team_id   UUID        NOT NULL REFERENCES game_creator.participants (participant_id) ON DELETE CASCADE,
+    player_id UUID        NOT NULL REFERENCES game_creator.participants (participant_id) ON DELETE CASCADE,
+    role      VARCHAR(64) NOT NULL DEFAULT '',
+    PRIMARY KEY (team_id, player_id)
+);
+
+-- Rows written before participants existed reference nothing, so the
+-- constraints only apply to new rows.
+ALTER TABLE game_creator.game_participants
+    ADD CONSTRAINT game_participants_participant_fk
+        FOREIGN KEY (participant_id) REFERENCES game_creator.participants (participant_id) NOT VALID,
+    ADD CONSTRAINT game_participants_unique_participant UNIQUE (game_id, participant_id);
+
+ALTER TABLE game_creator.results
+    ADD CONSTRAINT results_winner_fk
+        FOREIGN KEY (winner_id) REFERENCES game_creator.participants (participant_id) NOT VALID;
+--rollback ALTER TABLE game_creator.results DROP CONSTRAINT results_winner_fk;
+--rollback ALTER TABLE game_creator.game_participants DROP CONSTRAINT game_participants_unique_participant, DROP CONSTRAINT game_participants_participant_fk;
+--rollback DROP TABLE game_creator.team_members;
+--rollback DROP TABLE game_creator.participants;
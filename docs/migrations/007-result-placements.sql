@@ -0,0 +1,16 @@
+--liquibase formatted sql
+
+--changeset game-creator:007-result-placements
+CREATE TABLE game_creator.result_placements
+(
+    result_id      UUID             NOT NULL REFERENCES game_creator.results (result_id) ON DELETE CASCADE,
+    participant_id UUID             NOT NULL,
+    place          INT              NOT NULL CHECK (place > 0),
+    score          DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY (result_id, participant_id)
+);
+
+INSERT INTO game_creator.result_placements (result_id, participant_id, place)
+SELECT result_id, winner_id, 1
+FROM game_creator.results;
+--rollback DROP TABLE game_creator.result_placements;
@@ -0,0 +1,8 @@
+--liquibase formatted sql
+
+--changeset game-creator:008-result-outcomes
+ALTER TABLE game_creator.results
+    ALTER COLUMN winner_id DROP NOT NULL,
+    ADD COLUMN outcome      VARCHAR(32) NOT NULL DEFAULT 'win',
+    ADD COLUMN forfeited_by UUID REFERENCES game_creator.participants (participant_id) NOT VALID;
+--rollback ALTER TABLE game_creator.results DROP COLUMN forfeited_by, DROP COLUMN outcome;
@@ -0,0 +1,5 @@
+--liquibase formatted sql
+
+--changeset game-creator:009-game-types
+CREATE UNIQUE INDEX game_types_platform_name_idx ON game_creator.game_types (platform_name);
+--rollback DROP INDEX game_creator.game_types_platform_name_idx;
@@ -0,0 +1,11 @@
+--liquibase formatted sql
+
+--changeset game-creator:010-games-listing
+CREATE INDEX games_start_idx ON game_creator.games (game_start, game_id);
+CREATE INDEX games_type_start_idx ON game_creator.games (game_type_id, game_start, game_id);
+CREATE INDEX games_tournament_start_idx ON game_creator.games (tournament_id, game_start, game_id);
+CREATE INDEX results_game_idx ON game_creator.results (game_id);
+--rollback DROP INDEX game_creator.results_game_idx;
+--rollback DROP INDEX game_creator.games_tournament_start_idx;
+--rollback DROP INDEX game_creator.games_type_start_idx;
+--rollback DROP INDEX game_creator.games_start_idx;
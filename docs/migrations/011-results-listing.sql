@@ -0,0 +1,11 @@
+--liquibase formatted sql
+
+--changeset game-creator:011-results-listing
+-- Results recorded before this change get the time of the migration.
+ALTER TABLE game_creator.results
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE INDEX results_created_idx ON game_creator.results (created_at, result_id);
+CREATE INDEX result_placements_participant_idx ON game_creator.result_placements (participant_id, place);
+--rollback DROP INDEX game_creator.result_placements_participant_idx;
+--rollback DROP INDEX game_creator.results_created_idx;
+--rollback ALTER TABLE game_creator.results DROP COLUMN created_at;
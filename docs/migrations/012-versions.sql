@@ -0,0 +1,9 @@
+--liquibase formatted sql
+
+--changeset game-creator:012-versions
+ALTER TABLE game_creator.games
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE game_creator.results
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+--rollback ALTER TABLE game_creator.results DROP COLUMN version;
+--rollback ALTER TABLE game_creator.games DROP COLUMN version;
@@ -0,0 +1,8 @@
+--liquibase formatted sql
+
+--changeset game-creator:013-one-result-per-game
+-- Games with several results must be cleaned up before this change.
+DROP INDEX game_creator.results_game_idx;
+CREATE UNIQUE INDEX results_game_unique_idx ON game_creator.results (game_id);
+--rollback DROP INDEX game_creator.results_game_unique_idx;
+--rollback CREATE INDEX results_game_idx ON game_creator.results (game_id);
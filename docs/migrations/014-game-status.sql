@@ -0,0 +1,14 @@
+--liquibase formatted sql
+
+--changeset game-creator:014-game-status
+ALTER TABLE game_creator.games
+    ADD COLUMN status VARCHAR(32) NOT NULL DEFAULT 'scheduled';
+
+UPDATE game_creator.games g
+SET status = CASE r.outcome WHEN 'cancelled' THEN 'cancelled' ELSE 'finished' END
+FROM game_creator.results r
+WHERE r.game_id = g.game_id;
+
+CREATE INDEX games_status_start_idx ON game_creator.games (status, game_start, game_id);
+--rollback DROP INDEX game_creator.games_status_start_idx;
+--rollback ALTER TABLE game_creator.games DROP COLUMN status;
@@ -0,0 +1,21 @@
+--liquibase formatted sql
+
+--changeset game-creator:015-outbox
+-- Events of games and results, written in the transaction of the change and
+-- relayed to other services afterwards.
+CREATE TABLE game_creator.outbox
+(
+    event_id     BIGSERIAL PRIMARY KEY,
+    entity       VARCHAR(32) NOT NULL,
+    entity_id    UUID        NOT NULL,
+    event_type   VARCHAR(32) NOT NULL,
+    payload      JSONB       NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    attempts     INT         NOT NULL DEFAULT 0,
+    last_error   TEXT,
+    delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX outbox_pending_idx ON game_creator.outbox (event_id) WHERE delivered_at IS NULL;
+--rollback DROP TABLE game_creator.outbox;
@@ -0,0 +1,36 @@
+--liquibase formatted sql
+
+--changeset game-creator:016-webhooks
+CREATE TABLE game_creator.webhooks
+(
+    webhook_id    UUID PRIMARY KEY,
+    url           TEXT        NOT NULL,
+    secret        TEXT        NOT NULL,
+    event_types   TEXT[]      NOT NULL DEFAULT '{}',
+    game_statuses TEXT[]      NOT NULL DEFAULT '{}',
+    tournament_id UUID REFERENCES game_creator.tournaments (tournament_id) ON DELETE CASCADE,
+    active        BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE game_creator.webhook_deliveries
+(
+    delivery_id     UUID PRIMARY KEY,
+    webhook_id      UUID        NOT NULL REFERENCES game_creator.webhooks (webhook_id) ON DELETE CASCADE,
+    sequence        BIGINT      NOT NULL,
+    event_type      VARCHAR(64) NOT NULL,
+    payload         JSONB       NOT NULL,
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts        INT         NOT NULL DEFAULT 0,
+    last_error      TEXT        NOT NULL DEFAULT '',
+    response_status INT         NOT NULL DEFAULT 0,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    delivered_at    TIMESTAMPTZ,
+    UNIQUE (webhook_id, sequence)
+);
+
+CREATE INDEX webhook_deliveries_pending_idx ON game_creator.webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_history_idx ON game_creator.webhook_deliveries (webhook_id, created_at, delivery_id);
+--rollback DROP TABLE game_creator.webhook_deliveries;
+--rollback DROP TABLE game_creator.webhooks;
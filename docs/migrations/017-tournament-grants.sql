@@ -0,0 +1,15 @@
+--liquibase formatted sql
+
+--changeset game-creator:017-tournament-grants
+-- Who may manage a tournament besides admins. Subjects are the "sub" claims
+-- of bearer tokens.
+CREATE TABLE game_creator.tournament_grants
+(
+    tournament_id UUID         NOT NULL REFERENCES game_creator.tournaments (tournament_id) ON DELETE CASCADE,
+    subject       VARCHAR(255) NOT NULL,
+    access        VARCHAR(16)  NOT NULL,
+    granted_by    VARCHAR(255) NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    PRIMARY KEY (tournament_id, subject)
+);
+--rollback DROP TABLE game_creator.tournament_grants;
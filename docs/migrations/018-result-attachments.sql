@@ -0,0 +1,21 @@
+--liquibase formatted sql
+
+--changeset game-creator:018-result-attachments
+-- Evidence attached to results. Files live in the blob store under
+-- storage_key, demo links only have a url.
+CREATE TABLE game_creator.result_attachments
+(
+    attachment_id UUID PRIMARY KEY,
+    result_id     UUID         NOT NULL REFERENCES game_creator.results (result_id) ON DELETE CASCADE,
+    kind          VARCHAR(16)  NOT NULL,
+    name          VARCHAR(255) NOT NULL DEFAULT '',
+    content_type  VARCHAR(255) NOT NULL DEFAULT '',
+    size_bytes    BIGINT       NOT NULL DEFAULT 0,
+    sha256        VARCHAR(64)  NOT NULL DEFAULT '',
+    url           TEXT         NOT NULL DEFAULT '',
+    storage_key   TEXT         NOT NULL DEFAULT '',
+    uploaded_by   VARCHAR(255) NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+CREATE INDEX result_attachments_result_idx ON game_creator.result_attachments (result_id);
+--rollback DROP TABLE game_creator.result_attachments;
@@ -0,0 +1,57 @@
+--liquibase formatted sql
+
+--changeset game-creator:019-result-reviews
+-- Participants report results themselves: matching reports confirm the
+-- result, differing ones open a dispute a referee resolves. Results are only
+-- written once a review is confirmed or resolved.
+ALTER TABLE game_creator.participants
+    ADD COLUMN subject VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE TABLE game_creator.result_reviews
+(
+    game_id     UUID PRIMARY KEY REFERENCES game_creator.games (game_id) ON DELETE CASCADE,
+    status      VARCHAR(16)  NOT NULL DEFAULT 'reported',
+    result_id   UUID REFERENCES game_creator.results (result_id) ON DELETE SET NULL,
+    resolved_by VARCHAR(255) NOT NULL DEFAULT '',
+    resolution  TEXT         NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE TABLE game_creator.result_reports
+(
+    report_id      UUID PRIMARY KEY,
+    game_id        UUID         NOT NULL REFERENCES game_creator.result_reviews (game_id) ON DELETE CASCADE,
+    participant_id UUID         NOT NULL REFERENCES game_creator.participants (participant_id),
+    subject        VARCHAR(255) NOT NULL DEFAULT '',
+    outcome        VARCHAR(32)  NOT NULL,
+    winner_id      UUID,
+    forfeited_by   UUID,
+    placements     JSONB        NOT NULL DEFAULT '[]',
+    comment        TEXT         NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    UNIQUE (game_id, participant_id)
+);
+
+CREATE TABLE game_creator.dispute_comments
+(
+    comment_id UUID PRIMARY KEY,
+    game_id    UUID         NOT NULL REFERENCES game_creator.result_reviews (game_id) ON DELETE CASCADE,
+    subject    VARCHAR(255) NOT NULL DEFAULT '',
+    body       TEXT         NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+CREATE INDEX dispute_comments_game_idx ON game_creator.dispute_comments (game_id, created_at);
+
+-- Evidence is attached either to a result or to a report.
+ALTER TABLE game_creator.result_attachments
+    ALTER COLUMN result_id DROP NOT NULL,
+    ADD COLUMN report_id UUID REFERENCES game_creator.result_reports (report_id) ON DELETE CASCADE,
+    ADD CONSTRAINT result_attachments_owner_check CHECK ((result_id IS NULL) <> (report_id IS NULL));
+CREATE INDEX result_attachments_report_idx ON game_creator.result_attachments (report_id);
+--rollback DROP INDEX game_creator.result_attachments_report_idx;
+--rollback ALTER TABLE game_creator.result_attachments DROP CONSTRAINT result_attachments_owner_check, DROP COLUMN report_id, ALTER COLUMN result_id SET NOT NULL;
+--rollback DROP TABLE game_creator.dispute_comments;
+--rollback DROP TABLE game_creator.result_reports;
+--rollback DROP TABLE game_creator.result_reviews;
+--rollback ALTER TABLE game_creator.participants DROP COLUMN subject;
@@ -0,0 +1,17 @@
+--liquibase formatted sql
+
+--changeset game-creator:020-tournament-grants-backfill
+-- Tournaments created before 017 have no owner, so only admins can manage
+-- them. Nobody is recorded as their creator: the changelog parameter
+-- tournaments.legacy_owner names the subject that owns them from now on, e.g.
+-- liquibase update -Dtournaments.legacy_owner=<sub>. Without the parameter
+-- nothing is inserted and an admin has to grant owners with GrantAccess.
+INSERT INTO game_creator.tournament_grants (tournament_id, subject, access, granted_by)
+SELECT t.tournament_id, '${tournaments.legacy_owner}', 'owner', 'migration:020'
+FROM game_creator.tournaments t
+WHERE '${tournaments.legacy_owner}' NOT IN ('', '$' || '{tournaments.legacy_owner}')
+  AND NOT EXISTS (SELECT 1
+                  FROM game_creator.tournament_grants g
+                  WHERE g.tournament_id = t.tournament_id
+                    AND g.access = 'owner');
+--rollback DELETE FROM game_creator.tournament_grants WHERE granted_by = 'migration:020';
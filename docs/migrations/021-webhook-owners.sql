@@ -0,0 +1,8 @@
+--liquibase formatted sql
+
+--changeset game-creator:021-webhook-owners
+-- Webhooks belong to the subject that created them, only it and admins
+-- manage them. Webhooks created before are left to admins.
+ALTER TABLE game_creator.webhooks
+    ADD COLUMN created_by VARCHAR(255) NOT NULL DEFAULT '';
+--rollback ALTER TABLE game_creator.webhooks DROP COLUMN created_by;
@@ -0,0 +1,12 @@
+package auth
+
+import (
+	"context"
+	"tournaments-core/internal/domain/models"
+)
+
+// Verifier turns a bearer token into the principal it was issued to. It
+// returns a domain.ErrUnauthenticated error for tokens that are not valid.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (models.Principal, error)
+}
@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// leeway absorbs clock skew between the issuer and this service.
+const leeway = 30 * time.Second
+
+// JWTVerifier checks HS256 and RS256 signed JWTs locally. Only the algorithms
+// a key was configured for are accepted, so an RS256 public key can never be
+// used as an HS256 secret. Tokens must expire; the roles come from the
+// "roles" claim, a list, or the "role" claim, a single role.
+type JWTVerifier struct {
+	secret    []byte
+	publicKey *rsa.PublicKey
+	issuer    string
+	audience  string
+	now       func() time.Time
+}
+
+type JWTOption func(*JWTVerifier)
+
+func WithHS256(secret []byte) JWTOption {
+	return func(v *JWTVerifier) { v.secret = secret }
+}
+
+func WithRS256(publicKey *rsa.PublicKey) JWTOption {
+	return func(v *JWTVerifier) { v.publicKey = publicKey }
+}
+
+// WithIssuer requires the "iss" claim to match.
+func WithIssuer(issuer string) JWTOption {
+	return func(v *JWTVerifier) { v.issuer = issuer }
+}
+
+// WithAudience requires the "aud" claim to contain the audience.
+func WithAudience(audience string) JWTOption {
+	return func(v *JWTVerifier) { v.audience = audience }
+}
+
+func NewJWTVerifier(options ...JWTOption) (*JWTVerifier, error) {
+	v := &JWTVerifier{now: time.Now}
+	for _, o := range options {
+		o(v)
+	}
+	if len(v.secret) == 0 && v.publicKey == nil {
+		return nil, errors.New("jwt: neither an HS256 secret nor an RS256 public key is configured")
+	}
+	return v, nil
+}
+
+// ParseRSAPublicKey reads a PEM encoded PKIX or PKCS #1 RSA public key.
+func ParseRSAPublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("jwt: no PEM block in public key")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: failed to parse public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("jwt: public key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string        `json:"sub"`
+	Issuer    string        `json:"iss"`
+	Audience  audience      `json:"aud"`
+	ExpiresAt *int64        `json:"exp"`
+	NotBefore *int64        `json:"nbf"`
+	Roles     []models.Role `json:"roles"`
+	Role      models.Role   `json:"role"`
+}
+
+// audience is the "aud" claim, which is either a string or a list.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+func (v *JWTVerifier) Verify(_ context.Context, token string) (models.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return models.Principal{}, domain.Unauthenticated("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return models.Principal{}, domain.Unauthenticated("malformed token header")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return models.Principal{}, domain.Unauthenticated("malformed token signature")
+	}
+	if err := v.verifySignature(header.Alg, parts[0]+"."+parts[1], signature); err != nil {
+		return models.Principal{}, err
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return models.Principal{}, domain.Unauthenticated("malformed token claims")
+	}
+
+	return v.principal(claims)
+}
+
+func (v *JWTVerifier) verifySignature(alg, signed string, signature []byte) error {
+	switch {
+	case alg == "HS256" && len(v.secret) > 0:
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return domain.Unauthenticated("invalid token signature")
+		}
+		return nil
+	case alg == "RS256" && v.publicKey != nil:
+		digest := sha256.Sum256([]byte(signed))
+		if err := rsa.VerifyPKCS1v15(v.publicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return domain.Unauthenticated("invalid token signature")
+		}
+		return nil
+	}
+	return domain.Unauthenticated("token algorithm %q is not accepted", alg)
+}
+
+func (v *JWTVerifier) principal(claims jwtClaims) (models.Principal, error) {
+	now := v.now()
+
+	if claims.ExpiresAt == nil {
+		return models.Principal{}, domain.Unauthenticated("token has no expiry")
+	}
+	expiresAt := time.Unix(*claims.ExpiresAt, 0)
+	if now.After(expiresAt.Add(leeway)) {
+		return models.Principal{}, domain.Unauthenticated("token expired")
+	}
+	if claims.NotBefore != nil && now.Add(leeway).Before(time.Unix(*claims.NotBefore, 0)) {
+		return models.Principal{}, domain.Unauthenticated("token is not valid yet")
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return models.Principal{}, domain.Unauthenticated("token issuer %q is not accepted", claims.Issuer)
+	}
+	if v.audience != "" && !slices.Contains(claims.Audience, v.audience) {
+		return models.Principal{}, domain.Unauthenticated("token is not issued for %q", v.audience)
+	}
+	if claims.Subject == "" {
+		return models.Principal{}, domain.Unauthenticated("token has no subject")
+	}
+
+	roles := claims.Roles
+	if claims.Role != "" {
+		roles = append(roles, claims.Role)
+	}
+
+	return models.Principal{
+		Subject:   claims.Subject,
+		Roles:     roles,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
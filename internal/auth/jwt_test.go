@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+var (
+	testSecret = []byte("0123456789abcdef0123456789abcdef")
+	testNow    = time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+)
+
+// signed builds a token with the header algorithm alg, signed by sign.
+func signed(t *testing.T, alg string, claims map[string]any, sign func(signing string) []byte) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signing := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signing + "." + base64.RawURLEncoding.EncodeToString(sign(signing))
+}
+
+func hs256(key []byte) func(string) []byte {
+	return func(signing string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(signing))
+		return mac.Sum(nil)
+	}
+}
+
+func rs256(t *testing.T, key *rsa.PrivateKey) func(string) []byte {
+	return func(signing string) []byte {
+		digest := sha256.Sum256([]byte(signing))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signature
+	}
+}
+
+// validClaims expire an hour after testNow.
+func validClaims() map[string]any {
+	return map[string]any{
+		"sub":   "organiser-1",
+		"iss":   "auth",
+		"aud":   []string{"tournaments"},
+		"exp":   testNow.Add(time.Hour).Unix(),
+		"roles": []string{"organiser"},
+	}
+}
+
+func with(claims map[string]any, key string, value any) map[string]any {
+	claims[key] = value
+	return claims
+}
+
+func without(claims map[string]any, key string) map[string]any {
+	delete(claims, key)
+	return claims
+}
+
+func TestJWTVerifier(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)
+
+	hsVerifier, err := NewJWTVerifier(WithHS256(testSecret), WithIssuer("auth"), WithAudience("tournaments"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsVerifier, err := NewJWTVerifier(WithRS256(&rsaKey.PublicKey), WithAudience("tournaments"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []*JWTVerifier{hsVerifier, rsVerifier} {
+		v.now = func() time.Time { return testNow }
+	}
+
+	tests := []struct {
+		name     string
+		verifier *JWTVerifier
+		token    string
+		// wantErr is empty for a token that is accepted.
+		wantErr string
+	}{
+		{
+			name:     "valid hs256",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", validClaims(), hs256(testSecret)),
+		},
+		{
+			name:     "valid rs256",
+			verifier: rsVerifier,
+			token:    signed(t, "RS256", validClaims(), rs256(t, rsaKey)),
+		},
+		{
+			name:     "expired within leeway",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", with(validClaims(), "exp", testNow.Add(-leeway/2).Unix()), hs256(testSecret)),
+		},
+		{
+			name:     "expired",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", with(validClaims(), "exp", testNow.Add(-time.Minute).Unix()), hs256(testSecret)),
+			wantErr:  "token expired",
+		},
+		{
+			name:     "no expiry",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", without(validClaims(), "exp"), hs256(testSecret)),
+			wantErr:  "token has no expiry",
+		},
+		{
+			name:     "not valid yet",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", with(validClaims(), "nbf", testNow.Add(time.Minute).Unix()), hs256(testSecret)),
+			wantErr:  "token is not valid yet",
+		},
+		{
+			name:     "wrong audience",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", with(validClaims(), "aud", "billing"), hs256(testSecret)),
+			wantErr:  `token is not issued for "tournaments"`,
+		},
+		{
+			name:     "wrong issuer",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", with(validClaims(), "iss", "elsewhere"), hs256(testSecret)),
+			wantErr:  `token issuer "elsewhere" is not accepted`,
+		},
+		{
+			name:     "no subject",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", without(validClaims(), "sub"), hs256(testSecret)),
+			wantErr:  "token has no subject",
+		},
+		{
+			name:     "wrong secret",
+			verifier: hsVerifier,
+			token:    signed(t, "HS256", validClaims(), hs256([]byte("another secret of the issuer"))),
+			wantErr:  "invalid token signature",
+		},
+		{
+			name:     "algorithm none",
+			verifier: hsVerifier,
+			token:    signed(t, "none", validClaims(), func(string) []byte { return nil }),
+			wantErr:  `token algorithm "none" is not accepted`,
+		},
+		{
+			name:     "rs256 without a public key",
+			verifier: hsVerifier,
+			token:    signed(t, "RS256", validClaims(), rs256(t, rsaKey)),
+			wantErr:  `token algorithm "RS256" is not accepted`,
+		},
+		{
+			name:     "public key used as an hs256 secret",
+			verifier: rsVerifier,
+			token:    signed(t, "HS256", validClaims(), hs256(publicKey)),
+			wantErr:  `token algorithm "HS256" is not accepted`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := tt.verifier.Verify(context.Background(), tt.token)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+				if principal.Subject != "organiser-1" || !principal.HasRole(models.RoleOrganiser) {
+					t.Errorf("Verify() = %+v, want organiser-1 with the organiser role", principal)
+				}
+				return
+			}
+
+			var derr *domain.Error
+			if !errors.As(err, &derr) || derr.Kind != domain.ErrUnauthenticated {
+				t.Fatalf("Verify() error = %v, want unauthenticated", err)
+			}
+			if derr.Message != tt.wantErr {
+				t.Errorf("Verify() error = %q, want %q", derr.Message, tt.wantErr)
+			}
+		})
+	}
+}
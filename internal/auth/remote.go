@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"time"
+	"tournaments-core/internal/delivery/grpc/auth_grpc"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// RemoteVerifier asks the auth service to check tokens, for deployments where
+// signing keys are not shared with this service.
+type RemoteVerifier struct {
+	conn   *grpc.ClientConn
+	client auth_grpc.AuthServiceClient
+}
+
+func NewRemoteVerifier(target string) (*RemoteVerifier, error) {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteVerifier{conn: conn, client: auth_grpc.NewAuthServiceClient(conn)}, nil
+}
+
+func (v *RemoteVerifier) Verify(ctx context.Context, token string) (models.Principal, error) {
+	resp, err := v.client.VerifyToken(ctx, &auth_grpc.VerifyTokenRequest{Token: token})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated, codes.InvalidArgument, codes.PermissionDenied:
+			return models.Principal{}, domain.Unauthenticated("%s", status.Convert(err).Message())
+		}
+		return models.Principal{}, &domain.Error{
+			Kind:    domain.ErrUnavailable,
+			Reason:  "AUTH_UNAVAILABLE",
+			Message: "auth service is unavailable",
+			Err:     err,
+		}
+	}
+
+	// Like local tokens, principals must name a subject and expire: streams
+	// are cut off at the expiry.
+	if resp.GetSubject() == "" {
+		return models.Principal{}, domain.Unauthenticated("token has no subject")
+	}
+	if resp.GetExpiresAt() == nil {
+		return models.Principal{}, domain.Unauthenticated("token has no expiry")
+	}
+	expiresAt := resp.GetExpiresAt().AsTime()
+	if !time.Now().Before(expiresAt) {
+		return models.Principal{}, domain.Unauthenticated("token expired")
+	}
+
+	roles := make([]models.Role, 0, len(resp.GetRoles()))
+	for _, r := range resp.GetRoles() {
+		roles = append(roles, models.Role(r))
+	}
+
+	return models.Principal{
+		Subject:   resp.GetSubject(),
+		Roles:     roles,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (v *RemoteVerifier) Close() error {
+	return v.conn.Close()
+}
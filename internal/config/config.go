@@ -5,8 +5,14 @@ import "os"
 type Config struct {
 	GrpcConfig     GrpcConfig
 	DatabaseConfig DatabaseConfig
+	OutboxConfig   OutboxConfig
+	AuthConfig     AuthConfig
+	StorageConfig  StorageConfig
 }
 
+// GrpcConfig.Auth is the address of the auth service, it checks tokens when
+// no signing key is configured in AuthConfig. GrpcConfig.Storage is the
+// address of the storage service result attachments are kept in.
 type GrpcConfig struct {
 	Auth    string
 	Storage string
@@ -22,6 +28,28 @@ type DatabaseConfig struct {
 	SslMode  string
 }
 
+// OutboxConfig lists the sinks outbox events are relayed to, separated by
+// commas: log and webhook.
+type OutboxConfig struct {
+	Sinks      string
+	WebhookURL string
+}
+
+// AuthConfig holds the keys bearer JWTs are checked with locally: an HS256
+// secret, an RS256 public key in a PEM file or both.
+type AuthConfig struct {
+	JWTSecret        string
+	JWTPublicKeyFile string
+	Issuer           string
+	Audience         string
+}
+
+// StorageConfig.Dir is the directory result attachments are kept in when no
+// storage service is configured.
+type StorageConfig struct {
+	Dir string
+}
+
 func MustLoad() *Config {
 	return &Config{
 		GrpcConfig: GrpcConfig{
@@ -37,5 +65,18 @@ func MustLoad() *Config {
 			Name:     os.Getenv("DB_NAME"),
 			SslMode:  os.Getenv("DB_SSL_MODE"),
 		},
+		OutboxConfig: OutboxConfig{
+			Sinks:      os.Getenv("OUTBOX_SINKS"),
+			WebhookURL: os.Getenv("OUTBOX_WEBHOOK_URL"),
+		},
+		AuthConfig: AuthConfig{
+			JWTSecret:        os.Getenv("AUTH_JWT_SECRET"),
+			JWTPublicKeyFile: os.Getenv("AUTH_JWT_PUBLIC_KEY_FILE"),
+			Issuer:           os.Getenv("AUTH_JWT_ISSUER"),
+			Audience:         os.Getenv("AUTH_JWT_AUDIENCE"),
+		},
+		StorageConfig: StorageConfig{
+			Dir: os.Getenv("STORAGE_DIR"),
+		},
 	}
 }
@@ -0,0 +1,188 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	uuid2 "github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"tournaments-core/internal/delivery/grpc/results_grpc"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// attachmentChunk is the most content sent in a single download message.
+const attachmentChunk = 64 << 10
+
+func (s res_server) UploadAttachment(stream results_grpc.ResultsService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message must describe the attachment")
+	}
+	resultUuid, err := parseNullUUID(info.GetResultId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	reportUuid, err := parseNullUUID(info.GetReportId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	attachment := models.Attachment{
+		ResultID:    resultUuid,
+		ReportID:    reportUuid,
+		Kind:        models.AttachmentKind(info.GetKind()),
+		Name:        info.GetName(),
+		ContentType: info.GetContentType(),
+	}
+	if err := s.attachments.Upload(stream.Context(), &attachment, &uploadReader{stream: stream}); err != nil {
+		return toStatus(err)
+	}
+
+	return stream.SendAndClose(toAttachment(attachment))
+}
+
+// uploadReader reads the content of an upload from the chunks that follow
+// its description.
+type uploadReader struct {
+	stream results_grpc.ResultsService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetInfo() != nil {
+			return 0, domain.InvalidArgument("the attachment is described more than once")
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (s res_server) AddAttachmentLink(ctx context.Context, request *results_grpc.AddAttachmentLinkRequest) (*results_grpc.Attachment, error) {
+	resultUuid, err := parseNullUUID(request.GetResultId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	reportUuid, err := parseNullUUID(request.GetReportId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	attachment := models.Attachment{
+		ResultID: resultUuid,
+		ReportID: reportUuid,
+		Name:     request.GetName(),
+		URL:      request.GetUrl(),
+	}
+	if err := s.attachments.AddLink(ctx, &attachment); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toAttachment(attachment), nil
+}
+
+func (s res_server) ListAttachments(ctx context.Context, request *results_grpc.IdResultRequest) (*results_grpc.ListAttachmentsResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	attachments, err := s.attachments.FetchByResult(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &results_grpc.ListAttachmentsResponse{}
+	for _, a := range attachments {
+		response.Attachments = append(response.Attachments, toAttachment(a))
+	}
+
+	return response, nil
+}
+
+func (s res_server) DownloadAttachment(request *results_grpc.IdAttachmentRequest, stream results_grpc.ResultsService_DownloadAttachmentServer) error {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	attachment, content, err := s.attachments.Download(stream.Context(), uuid)
+	if err != nil {
+		return toStatus(err)
+	}
+	defer content.Close()
+
+	info := &results_grpc.DownloadAttachmentResponse_Info{Info: toAttachment(attachment)}
+	if err := stream.Send(&results_grpc.DownloadAttachmentResponse{Data: info}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, attachmentChunk)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			chunk := &results_grpc.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]}
+			if err := stream.Send(&results_grpc.DownloadAttachmentResponse{Data: chunk}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return toStatus(err)
+		}
+	}
+}
+
+func (s res_server) DeleteAttachment(ctx context.Context, request *results_grpc.IdAttachmentRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.attachments.DeleteById(ctx, uuid); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toAttachment(a models.Attachment) *results_grpc.Attachment {
+	var resultId, reportId string
+	if a.ResultID.Valid {
+		resultId = a.ResultID.UUID.String()
+	}
+	if a.ReportID.Valid {
+		reportId = a.ReportID.UUID.String()
+	}
+
+	return &results_grpc.Attachment{
+		Id:          a.AttachmentID.String(),
+		ResultId:    resultId,
+		ReportId:    reportId,
+		Kind:        string(a.Kind),
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.SHA256,
+		Url:         a.URL,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
@@ -0,0 +1,190 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"strings"
+	"tournaments-core/internal/auth"
+	"tournaments-core/internal/delivery/grpc/game_types_grpc"
+	"tournaments-core/internal/delivery/grpc/games_grpc"
+	"tournaments-core/internal/delivery/grpc/participants_grpc"
+	"tournaments-core/internal/delivery/grpc/results_grpc"
+	"tournaments-core/internal/delivery/grpc/standings_grpc"
+	"tournaments-core/internal/delivery/grpc/tournaments_grpc"
+	"tournaments-core/internal/delivery/grpc/webhooks_grpc"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+var (
+	// anyRole lets every authenticated caller read.
+	anyRole    = []models.Role{models.RoleViewer, models.RoleReferee, models.RoleOrganiser}
+	organisers = []models.Role{models.RoleOrganiser}
+	// officials run games: referees report results and move games along.
+	officials = []models.Role{models.RoleReferee, models.RoleOrganiser}
+	// adminOnly is left empty on purpose, admins may call every method.
+	adminOnly []models.Role
+)
+
+// methodRoles lists the roles that may call each method besides admins.
+// Methods missing here are admin only. Changes to a tournament, its games and
+// results also need a grant on the tournament, the use cases check those.
+// Participants report results and attach evidence with any role, the use
+// cases let them act only for the participants they sign in as.
+var methodRoles = map[string][]models.Role{
+	tournaments_grpc.TournamentsService_FetchById_FullMethodName:       anyRole,
+	tournaments_grpc.TournamentsService_SwissStandings_FullMethodName:  anyRole,
+	tournaments_grpc.TournamentsService_WatchTournament_FullMethodName: anyRole,
+	tournaments_grpc.TournamentsService_Create_FullMethodName:          organisers,
+	tournaments_grpc.TournamentsService_Update_FullMethodName:          organisers,
+	tournaments_grpc.TournamentsService_DeleteById_FullMethodName:      organisers,
+	tournaments_grpc.TournamentsService_GenerateBracket_FullMethodName: organisers,
+	tournaments_grpc.TournamentsService_ListGrants_FullMethodName:      officials,
+	tournaments_grpc.TournamentsService_GrantAccess_FullMethodName:     organisers,
+	tournaments_grpc.TournamentsService_RevokeAccess_FullMethodName:    organisers,
+
+	games_grpc.GamesService_FetchById_FullMethodName:    anyRole,
+	games_grpc.GamesService_ListGames_FullMethodName:    anyRole,
+	games_grpc.GamesService_WatchGames_FullMethodName:   anyRole,
+	games_grpc.GamesService_Create_FullMethodName:       organisers,
+	games_grpc.GamesService_Update_FullMethodName:       organisers,
+	games_grpc.GamesService_DeleteById_FullMethodName:   organisers,
+	games_grpc.GamesService_PostponeGame_FullMethodName: organisers,
+	games_grpc.GamesService_CancelGame_FullMethodName:   organisers,
+	games_grpc.GamesService_OpenCheckIn_FullMethodName:  officials,
+	games_grpc.GamesService_StartGame_FullMethodName:    officials,
+
+	results_grpc.ResultsService_FetchById_FullMethodName:          anyRole,
+	results_grpc.ResultsService_FetchByGameId_FullMethodName:      anyRole,
+	results_grpc.ResultsService_ListResults_FullMethodName:        anyRole,
+	results_grpc.ResultsService_WatchResults_FullMethodName:       anyRole,
+	results_grpc.ResultsService_Create_FullMethodName:             officials,
+	results_grpc.ResultsService_Update_FullMethodName:             officials,
+	results_grpc.ResultsService_DeleteById_FullMethodName:         organisers,
+	results_grpc.ResultsService_ListAttachments_FullMethodName:    anyRole,
+	results_grpc.ResultsService_DownloadAttachment_FullMethodName: anyRole,
+	results_grpc.ResultsService_UploadAttachment_FullMethodName:   anyRole,
+	results_grpc.ResultsService_AddAttachmentLink_FullMethodName:  anyRole,
+	results_grpc.ResultsService_DeleteAttachment_FullMethodName:   anyRole,
+	results_grpc.ResultsService_FetchReview_FullMethodName:        anyRole,
+	results_grpc.ResultsService_ReportResult_FullMethodName:       anyRole,
+	results_grpc.ResultsService_CommentReview_FullMethodName:      anyRole,
+	results_grpc.ResultsService_ResolveReview_FullMethodName:      officials,
+
+	standings_grpc.StandingsService_Standings_FullMethodName: anyRole,
+
+	participants_grpc.ParticipantsService_FetchById_FullMethodName:  anyRole,
+	participants_grpc.ParticipantsService_Create_FullMethodName:     organisers,
+	participants_grpc.ParticipantsService_Update_FullMethodName:     organisers,
+	participants_grpc.ParticipantsService_DeleteById_FullMethodName: organisers,
+
+	game_types_grpc.GameTypesService_FetchById_FullMethodName:  anyRole,
+	game_types_grpc.GameTypesService_FetchAll_FullMethodName:   anyRole,
+	game_types_grpc.GameTypesService_Create_FullMethodName:     adminOnly,
+	game_types_grpc.GameTypesService_Update_FullMethodName:     adminOnly,
+	game_types_grpc.GameTypesService_DeleteById_FullMethodName: adminOnly,
+
+	webhooks_grpc.WebhooksService_FetchById_FullMethodName:      organisers,
+	webhooks_grpc.WebhooksService_FetchAll_FullMethodName:       organisers,
+	webhooks_grpc.WebhooksService_ListDeliveries_FullMethodName: organisers,
+	webhooks_grpc.WebhooksService_Redeliver_FullMethodName:      organisers,
+	webhooks_grpc.WebhooksService_Create_FullMethodName:         organisers,
+	webhooks_grpc.WebhooksService_Update_FullMethodName:         organisers,
+	webhooks_grpc.WebhooksService_DeleteById_FullMethodName:     organisers,
+}
+
+// publicServices need no token.
+var publicServices = []string{
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// AuthInterceptors authenticate every call with the bearer token of its
+// "authorization" metadata and check the caller's roles against the method.
+// The caller is available to handlers through domain.PrincipalFrom. Streams
+// outlive the check, so their context is cancelled when the token expires
+// and the stream ends as unauthenticated.
+func AuthInterceptors(verifier auth.Verifier) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return handler(ctx, req)
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return toStatus(err)
+		}
+
+		principal, ok := domain.PrincipalFrom(ctx)
+		if !ok || principal.ExpiresAt.IsZero() {
+			return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+		}
+
+		expired := domain.Unauthenticated("token expired")
+		ctx, cancel := context.WithDeadlineCause(ctx, principal.ExpiresAt, expired)
+		defer cancel()
+
+		err = handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+		if errors.Is(context.Cause(ctx), expired) {
+			return toStatus(expired)
+		}
+		return err
+	}
+
+	return unary, stream
+}
+
+func authorize(ctx context.Context, verifier auth.Verifier, method string) (context.Context, error) {
+	for _, prefix := range publicServices {
+		if strings.HasPrefix(method, prefix) {
+			return ctx, nil
+		}
+	}
+
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, err := verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !principal.HasAnyRole(methodRoles[method]...) {
+		return nil, domain.PermissionDenied("%s may not call %s", principal.Subject, method)
+	}
+
+	return domain.WithPrincipal(ctx, principal), nil
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", domain.Unauthenticated("authorization metadata is missing")
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", domain.Unauthenticated("authorization metadata must be a bearer token")
+	}
+
+	return token, nil
+}
+
+// authorizedStream hands handlers the context carrying the caller.
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
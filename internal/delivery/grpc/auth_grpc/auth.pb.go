@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: internal/delivery/grpc/auth_grpc/auth.proto
+
+package auth_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VerifyTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTokenRequest) Reset() {
+	*x = VerifyTokenRequest{}
+	mi := &file_internal_delivery_grpc_auth_grpc_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTokenRequest) ProtoMessage() {}
+
+func (x *VerifyTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_auth_grpc_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTokenRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTokenRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VerifyTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyTokenResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Subject string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// admin, organiser, referee or viewer.
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTokenResponse) Reset() {
+	*x = VerifyTokenResponse{}
+	mi := &file_internal_delivery_grpc_auth_grpc_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTokenResponse) ProtoMessage() {}
+
+func (x *VerifyTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_auth_grpc_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTokenResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTokenResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerifyTokenResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *VerifyTokenResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *VerifyTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_internal_delivery_grpc_auth_grpc_auth_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_auth_grpc_auth_proto_rawDesc = "" +
+	"\n" +
+	"+internal/delivery/grpc/auth_grpc/auth.proto\x12\x04auth\x1a\x1fgoogle/protobuf/timestamp.proto\"*\n" +
+	"\x12VerifyTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x80\x01\n" +
+	"\x13VerifyTokenResponse\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2Q\n" +
+	"\vAuthService\x12B\n" +
+	"\vVerifyToken\x12\x18.auth.VerifyTokenRequest\x1a\x19.auth.VerifyTokenResponseB\"Z internal/delivery/grpc/auth_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_auth_grpc_auth_proto_rawDesc), len(file_internal_delivery_grpc_auth_grpc_auth_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_auth_grpc_auth_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_auth_grpc_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_internal_delivery_grpc_auth_grpc_auth_proto_goTypes = []any{
+	(*VerifyTokenRequest)(nil),    // 0: auth.VerifyTokenRequest
+	(*VerifyTokenResponse)(nil),   // 1: auth.VerifyTokenResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_internal_delivery_grpc_auth_grpc_auth_proto_depIdxs = []int32{
+	2, // 0: auth.VerifyTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 1: auth.AuthService.VerifyToken:input_type -> auth.VerifyTokenRequest
+	1, // 2: auth.AuthService.VerifyToken:output_type -> auth.VerifyTokenResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_auth_grpc_auth_proto_init() }
+func file_internal_delivery_grpc_auth_grpc_auth_proto_init() {
+	if File_internal_delivery_grpc_auth_grpc_auth_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_auth_grpc_auth_proto_rawDesc), len(file_internal_delivery_grpc_auth_grpc_auth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_delivery_grpc_auth_grpc_auth_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_grpc_auth_grpc_auth_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_grpc_auth_grpc_auth_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_grpc_auth_grpc_auth_proto = out.File
+	file_internal_delivery_grpc_auth_grpc_auth_proto_goTypes = nil
+	file_internal_delivery_grpc_auth_grpc_auth_proto_depIdxs = nil
+}
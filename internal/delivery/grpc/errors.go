@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"tournaments-core/internal/domain"
+)
+
+// errorDomain is the ErrorInfo domain of errors raised by this service.
+const errorDomain = "game-creator"
+
+var errorCodes = []struct {
+	kind error
+	code codes.Code
+}{
+	// A stale version is a conflict that the client resolves by retrying.
+	{domain.ErrStaleVersion, codes.Aborted},
+	{domain.ErrNotFound, codes.NotFound},
+	{domain.ErrAlreadyExists, codes.AlreadyExists},
+	{domain.ErrInvalidArgument, codes.InvalidArgument},
+	{domain.ErrConflict, codes.FailedPrecondition},
+	{domain.ErrUnavailable, codes.Unavailable},
+	{domain.ErrUnauthenticated, codes.Unauthenticated},
+	{domain.ErrPermissionDenied, codes.PermissionDenied},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+	{context.Canceled, codes.Canceled},
+}
+
+// toStatus maps an error returned by a use case to a status. Domain errors
+// carry google.rpc.ErrorInfo details, google.rpc.ResourceInfo when the entity
+// type is known and google.rpc.BadRequest when fields are at fault. Anything
+// else is an internal error.
+func toStatus(err error) error {
+	code := codes.Internal
+	for _, c := range errorCodes {
+		if errors.Is(err, c.kind) {
+			code = c.code
+			break
+		}
+	}
+
+	st := status.New(code, err.Error())
+
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return st.Err()
+	}
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: domainErr.Reason, Domain: errorDomain},
+	}
+	if domainErr.Resource != "" {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: domainErr.Resource,
+			Description:  domainErr.Message,
+		})
+	}
+	if len(domainErr.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range domainErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: internal/delivery/grpc/game_types_grpc/game_types.proto
+
+package game_types_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IdGameTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdGameTypeRequest) Reset() {
+	*x = IdGameTypeRequest{}
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdGameTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdGameTypeRequest) ProtoMessage() {}
+
+func (x *IdGameTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdGameTypeRequest.ProtoReflect.Descriptor instead.
+func (*IdGameTypeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IdGameTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GameTypeCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformName  string                 `protobuf:"bytes,1,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTypeCreateRequest) Reset() {
+	*x = GameTypeCreateRequest{}
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTypeCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTypeCreateRequest) ProtoMessage() {}
+
+func (x *GameTypeCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTypeCreateRequest.ProtoReflect.Descriptor instead.
+func (*GameTypeCreateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameTypeCreateRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+type GameTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,2,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTypeRequest) Reset() {
+	*x = GameTypeRequest{}
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTypeRequest) ProtoMessage() {}
+
+func (x *GameTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTypeRequest.ProtoReflect.Descriptor instead.
+func (*GameTypeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GameTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameTypeRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+type GameTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlatformName  string                 `protobuf:"bytes,2,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTypeResponse) Reset() {
+	*x = GameTypeResponse{}
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTypeResponse) ProtoMessage() {}
+
+func (x *GameTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTypeResponse.ProtoReflect.Descriptor instead.
+func (*GameTypeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameTypeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameTypeResponse) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+type GameTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameTypes     []*GameTypeResponse    `protobuf:"bytes,1,rep,name=game_types,json=gameTypes,proto3" json:"game_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTypesResponse) Reset() {
+	*x = GameTypesResponse{}
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTypesResponse) ProtoMessage() {}
+
+func (x *GameTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTypesResponse.ProtoReflect.Descriptor instead.
+func (*GameTypesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameTypesResponse) GetGameTypes() []*GameTypeResponse {
+	if x != nil {
+		return x.GameTypes
+	}
+	return nil
+}
+
+var File_internal_delivery_grpc_game_types_grpc_game_types_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDesc = "" +
+	"\n" +
+	"7internal/delivery/grpc/game_types_grpc/game_types.proto\x12\n" +
+	"game_types\x1a\x1bgoogle/protobuf/empty.proto\"#\n" +
+	"\x11IdGameTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x15GameTypeCreateRequest\x12#\n" +
+	"\rplatform_name\x18\x01 \x01(\tR\fplatformName\"F\n" +
+	"\x0fGameTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rplatform_name\x18\x02 \x01(\tR\fplatformName\"G\n" +
+	"\x10GameTypeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rplatform_name\x18\x02 \x01(\tR\fplatformName\"P\n" +
+	"\x11GameTypesResponse\x12;\n" +
+	"\n" +
+	"game_types\x18\x01 \x03(\v2\x1c.game_types.GameTypeResponseR\tgameTypes2\xe8\x02\n" +
+	"\x10GameTypesService\x12H\n" +
+	"\tFetchById\x12\x1d.game_types.IdGameTypeRequest\x1a\x1c.game_types.GameTypeResponse\x12A\n" +
+	"\bFetchAll\x12\x16.google.protobuf.Empty\x1a\x1d.game_types.GameTypesResponse\x12C\n" +
+	"\n" +
+	"DeleteById\x12\x1d.game_types.IdGameTypeRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x06Update\x12\x1b.game_types.GameTypeRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x06Create\x12!.game_types.GameTypeCreateRequest\x1a\x16.google.protobuf.EmptyB(Z&internal/delivery/grpc/game_types_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDesc), len(file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_internal_delivery_grpc_game_types_grpc_game_types_proto_goTypes = []any{
+	(*IdGameTypeRequest)(nil),     // 0: game_types.IdGameTypeRequest
+	(*GameTypeCreateRequest)(nil), // 1: game_types.GameTypeCreateRequest
+	(*GameTypeRequest)(nil),       // 2: game_types.GameTypeRequest
+	(*GameTypeResponse)(nil),      // 3: game_types.GameTypeResponse
+	(*GameTypesResponse)(nil),     // 4: game_types.GameTypesResponse
+	(*emptypb.Empty)(nil),         // 5: google.protobuf.Empty
+}
+var file_internal_delivery_grpc_game_types_grpc_game_types_proto_depIdxs = []int32{
+	3, // 0: game_types.GameTypesResponse.game_types:type_name -> game_types.GameTypeResponse
+	0, // 1: game_types.GameTypesService.FetchById:input_type -> game_types.IdGameTypeRequest
+	5, // 2: game_types.GameTypesService.FetchAll:input_type -> google.protobuf.Empty
+	0, // 3: game_types.GameTypesService.DeleteById:input_type -> game_types.IdGameTypeRequest
+	2, // 4: game_types.GameTypesService.Update:input_type -> game_types.GameTypeRequest
+	1, // 5: game_types.GameTypesService.Create:input_type -> game_types.GameTypeCreateRequest
+	3, // 6: game_types.GameTypesService.FetchById:output_type -> game_types.GameTypeResponse
+	4, // 7: game_types.GameTypesService.FetchAll:output_type -> game_types.GameTypesResponse
+	5, // 8: game_types.GameTypesService.DeleteById:output_type -> google.protobuf.Empty
+	5, // 9: game_types.GameTypesService.Update:output_type -> google.protobuf.Empty
+	5, // 10: game_types.GameTypesService.Create:output_type -> google.protobuf.Empty
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_game_types_grpc_game_types_proto_init() }
+func file_internal_delivery_grpc_game_types_grpc_game_types_proto_init() {
+	if File_internal_delivery_grpc_game_types_grpc_game_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDesc), len(file_internal_delivery_grpc_game_types_grpc_game_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_delivery_grpc_game_types_grpc_game_types_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_grpc_game_types_grpc_game_types_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_grpc_game_types_grpc_game_types_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_grpc_game_types_grpc_game_types_proto = out.File
+	file_internal_delivery_grpc_game_types_grpc_game_types_proto_goTypes = nil
+	file_internal_delivery_grpc_game_types_grpc_game_types_proto_depIdxs = nil
+}
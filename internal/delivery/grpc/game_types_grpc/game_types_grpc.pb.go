@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0--rc1
+// source: internal/delivery/grpc/game_types_grpc/game_types.proto
+
+package game_types_grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GameTypesService_FetchById_FullMethodName  = "/game_types.GameTypesService/FetchById"
+	GameTypesService_FetchAll_FullMethodName   = "/game_types.GameTypesService/FetchAll"
+	GameTypesService_DeleteById_FullMethodName = "/game_types.GameTypesService/DeleteById"
+	GameTypesService_Update_FullMethodName     = "/game_types.GameTypesService/Update"
+	GameTypesService_Create_FullMethodName     = "/game_types.GameTypesService/Create"
+)
+
+// GameTypesServiceClient is the client API for GameTypesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameTypesServiceClient interface {
+	FetchById(ctx context.Context, in *IdGameTypeRequest, opts ...grpc.CallOption) (*GameTypeResponse, error)
+	FetchAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GameTypesResponse, error)
+	DeleteById(ctx context.Context, in *IdGameTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Update(ctx context.Context, in *GameTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Create(ctx context.Context, in *GameTypeCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gameTypesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameTypesServiceClient(cc grpc.ClientConnInterface) GameTypesServiceClient {
+	return &gameTypesServiceClient{cc}
+}
+
+func (c *gameTypesServiceClient) FetchById(ctx context.Context, in *IdGameTypeRequest, opts ...grpc.CallOption) (*GameTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameTypeResponse)
+	err := c.cc.Invoke(ctx, GameTypesService_FetchById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameTypesServiceClient) FetchAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GameTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameTypesResponse)
+	err := c.cc.Invoke(ctx, GameTypesService_FetchAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameTypesServiceClient) DeleteById(ctx context.Context, in *IdGameTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GameTypesService_DeleteById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameTypesServiceClient) Update(ctx context.Context, in *GameTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GameTypesService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameTypesServiceClient) Create(ctx context.Context, in *GameTypeCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GameTypesService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameTypesServiceServer is the server API for GameTypesService service.
+// All implementations must embed UnimplementedGameTypesServiceServer
+// for forward compatibility.
+type GameTypesServiceServer interface {
+	FetchById(context.Context, *IdGameTypeRequest) (*GameTypeResponse, error)
+	FetchAll(context.Context, *emptypb.Empty) (*GameTypesResponse, error)
+	DeleteById(context.Context, *IdGameTypeRequest) (*emptypb.Empty, error)
+	Update(context.Context, *GameTypeRequest) (*emptypb.Empty, error)
+	Create(context.Context, *GameTypeCreateRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGameTypesServiceServer()
+}
+
+// UnimplementedGameTypesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGameTypesServiceServer struct{}
+
+func (UnimplementedGameTypesServiceServer) FetchById(context.Context, *IdGameTypeRequest) (*GameTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchById not implemented")
+}
+func (UnimplementedGameTypesServiceServer) FetchAll(context.Context, *emptypb.Empty) (*GameTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchAll not implemented")
+}
+func (UnimplementedGameTypesServiceServer) DeleteById(context.Context, *IdGameTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteById not implemented")
+}
+func (UnimplementedGameTypesServiceServer) Update(context.Context, *GameTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGameTypesServiceServer) Create(context.Context, *GameTypeCreateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGameTypesServiceServer) mustEmbedUnimplementedGameTypesServiceServer() {}
+func (UnimplementedGameTypesServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeGameTypesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameTypesServiceServer will
+// result in compilation errors.
+type UnsafeGameTypesServiceServer interface {
+	mustEmbedUnimplementedGameTypesServiceServer()
+}
+
+func RegisterGameTypesServiceServer(s grpc.ServiceRegistrar, srv GameTypesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGameTypesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GameTypesService_ServiceDesc, srv)
+}
+
+func _GameTypesService_FetchById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdGameTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameTypesServiceServer).FetchById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameTypesService_FetchById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameTypesServiceServer).FetchById(ctx, req.(*IdGameTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameTypesService_FetchAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameTypesServiceServer).FetchAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameTypesService_FetchAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameTypesServiceServer).FetchAll(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameTypesService_DeleteById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdGameTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameTypesServiceServer).DeleteById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameTypesService_DeleteById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameTypesServiceServer).DeleteById(ctx, req.(*IdGameTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameTypesService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameTypesServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameTypesService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameTypesServiceServer).Update(ctx, req.(*GameTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameTypesService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameTypeCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameTypesServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameTypesService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameTypesServiceServer).Create(ctx, req.(*GameTypeCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameTypesService_ServiceDesc is the grpc.ServiceDesc for GameTypesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameTypesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "game_types.GameTypesService",
+	HandlerType: (*GameTypesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchById",
+			Handler:    _GameTypesService_FetchById_Handler,
+		},
+		{
+			MethodName: "FetchAll",
+			Handler:    _GameTypesService_FetchAll_Handler,
+		},
+		{
+			MethodName: "DeleteById",
+			Handler:    _GameTypesService_DeleteById_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GameTypesService_Update_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _GameTypesService_Create_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/delivery/grpc/game_types_grpc/game_types.proto",
+}
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	uuid2 "github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+	"tournaments-core/internal/delivery/grpc/game_types_grpc"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+	usecase2 "tournaments-core/internal/usecase"
+)
+
+type game_types_server struct {
+	game_types_grpc.UnimplementedGameTypesServiceServer
+	usecase usecase.GameTypesUseCase
+}
+
+func NewGameTypesGrpcServer(gserver *grpc.Server, rep *repository.GameTypesRepository) {
+
+	gameTypesServer := &game_types_server{
+		usecase: usecase2.NewGameTypesUseCase(*rep, 10*time.Second),
+	}
+
+	game_types_grpc.RegisterGameTypesServiceServer(gserver, gameTypesServer)
+}
+
+func (s game_types_server) FetchById(ctx context.Context, request *game_types_grpc.IdGameTypeRequest) (*game_types_grpc.GameTypeResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	gt, err := s.usecase.FetchById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &game_types_grpc.GameTypeResponse{
+		Id:           uuid.String(),
+		PlatformName: gt.PlatformName,
+	}, nil
+}
+
+func (s game_types_server) FetchAll(ctx context.Context, _ *emptypb.Empty) (*game_types_grpc.GameTypesResponse, error) {
+	gameTypes, err := s.usecase.FetchAll(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &game_types_grpc.GameTypesResponse{}
+	for _, gt := range gameTypes {
+		response.GameTypes = append(response.GameTypes, &game_types_grpc.GameTypeResponse{
+			Id:           gt.GameTypeID.String(),
+			PlatformName: gt.PlatformName,
+		})
+	}
+
+	return response, nil
+}
+
+func (s game_types_server) DeleteById(ctx context.Context, request *game_types_grpc.IdGameTypeRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.usecase.DeleteById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s game_types_server) Update(ctx context.Context, request *game_types_grpc.GameTypeRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var gameType *models.GameType
+	gameType = &models.GameType{
+		GameTypeID:   uuid,
+		PlatformName: request.GetPlatformName(),
+	}
+
+	err = s.usecase.Update(ctx, gameType)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s game_types_server) Create(ctx context.Context, request *game_types_grpc.GameTypeCreateRequest) (*emptypb.Empty, error) {
+	var gameType *models.GameType
+	gameType = &models.GameType{
+		GameTypeID:   uuid2.New(),
+		PlatformName: request.GetPlatformName(),
+	}
+
+	err := s.usecase.Create(ctx, gameType)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
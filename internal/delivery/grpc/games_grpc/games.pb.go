@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,8 +25,10 @@ const (
 )
 
 type IdGameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only DeleteById looks at the version: when set it must match the stored one.
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,10 +70,22 @@ func (x *IdGameRequest) GetId() string {
 	return ""
 }
 
+func (x *IdGameRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GameCreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameStart     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
-	GameTypeId    string                 `protobuf:"bytes,2,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	GameStart    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
+	GameTypeId   string                 `protobuf:"bytes,2,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	TournamentId string                 `protobuf:"bytes,3,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Round        int32                  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	Participants []*GameParticipant     `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Optional client-chosen id, generated when empty.
+	Id            string `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,11 +134,51 @@ func (x *GameCreateRequest) GetGameTypeId() string {
 	return ""
 }
 
+func (x *GameCreateRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GameCreateRequest) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GameCreateRequest) GetParticipants() []*GameParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *GameCreateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type GameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GameStart     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
-	GameTypeId    string                 `protobuf:"bytes,3,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameStart    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
+	GameTypeId   string                 `protobuf:"bytes,3,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	TournamentId string                 `protobuf:"bytes,4,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Round        int32                  `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty"`
+	// Participants are replaced only when replace_participants is set.
+	Participants        []*GameParticipant `protobuf:"bytes,6,rep,name=participants,proto3" json:"participants,omitempty"`
+	ReplaceParticipants bool               `protobuf:"varint,7,opt,name=replace_participants,json=replaceParticipants,proto3" json:"replace_participants,omitempty"`
+	// Fields to write: game_start, game_type_id, tournament_id, round and
+	// participants. Without a mask every set field is written and participants
+	// follow replace_participants.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set it must match the stored version, otherwise the update is
+	// aborted.
+	Version       int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -179,11 +234,63 @@ func (x *GameRequest) GetGameTypeId() string {
 	return ""
 }
 
+func (x *GameRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GameRequest) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GameRequest) GetParticipants() []*GameParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *GameRequest) GetReplaceParticipants() bool {
+	if x != nil {
+		return x.ReplaceParticipants
+	}
+	return false
+}
+
+func (x *GameRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *GameRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GameResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GameStart     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
-	GameTypeId    string                 `protobuf:"bytes,3,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameStart    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
+	GameTypeId   string                 `protobuf:"bytes,3,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	TournamentId string                 `protobuf:"bytes,4,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Round        int32                  `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty"`
+	Position     int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	Participants []*GameParticipant     `protobuf:"bytes,7,rep,name=participants,proto3" json:"participants,omitempty"`
+	Bracket      string                 `protobuf:"bytes,8,opt,name=bracket,proto3" json:"bracket,omitempty"`
+	Group        int32                  `protobuf:"varint,9,opt,name=group,proto3" json:"group,omitempty"`
+	PlatformName string                 `protobuf:"bytes,10,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	Version      int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// scheduled, check_in, live, finished, cancelled or postponed.
+	Status        string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -239,36 +346,630 @@ func (x *GameResponse) GetGameTypeId() string {
 	return ""
 }
 
+func (x *GameResponse) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GameResponse) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GameResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GameResponse) GetParticipants() []*GameParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *GameResponse) GetBracket() string {
+	if x != nil {
+		return x.Bracket
+	}
+	return ""
+}
+
+func (x *GameResponse) GetGroup() int32 {
+	if x != nil {
+		return x.Group
+	}
+	return 0
+}
+
+func (x *GameResponse) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *GameResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GameParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Slot          int32                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameParticipant) Reset() {
+	*x = GameParticipant{}
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameParticipant) ProtoMessage() {}
+
+func (x *GameParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameParticipant.ProtoReflect.Descriptor instead.
+func (*GameParticipant) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameParticipant) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *GameParticipant) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type ListGamesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Games starting in [start_from, start_to), either bound may be omitted.
+	StartFrom    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_from,json=startFrom,proto3" json:"start_from,omitempty"`
+	StartTo      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_to,json=startTo,proto3" json:"start_to,omitempty"`
+	GameTypeId   string                 `protobuf:"bytes,3,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	TournamentId string                 `protobuf:"bytes,4,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// One of the GameResponse statuses, empty for any.
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// "game_start" (default) or "round".
+	OrderBy       string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Descending    bool   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize      int32  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesRequest) ProtoMessage() {}
+
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListGamesRequest) GetStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartFrom
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetStartTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTo
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetGameTypeId() string {
+	if x != nil {
+		return x.GameTypeId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListGamesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*GameResponse        `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListGamesResponse) GetGames() []*GameResponse {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *ListGamesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Moves a game to another status. When version is set it must match the
+// stored one.
+type GameTransitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTransitionRequest) Reset() {
+	*x = GameTransitionRequest{}
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTransitionRequest) ProtoMessage() {}
+
+func (x *GameTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTransitionRequest.ProtoReflect.Descriptor instead.
+func (*GameTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GameTransitionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameTransitionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PostponeGameRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// New start of the game, the game stays postponed until it is rescheduled
+	// through Update.
+	GameStart     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostponeGameRequest) Reset() {
+	*x = PostponeGameRequest{}
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostponeGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostponeGameRequest) ProtoMessage() {}
+
+func (x *PostponeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostponeGameRequest.ProtoReflect.Descriptor instead.
+func (*PostponeGameRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PostponeGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostponeGameRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostponeGameRequest) GetGameStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GameStart
+	}
+	return nil
+}
+
+// Streams game changes as they happen. Events after from_sequence are sent
+// first, zero starts with the next change. Sequences are outbox positions and
+// survive restarts of the service.
+type WatchGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromSequence  uint64                 `protobuf:"varint,1,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGamesRequest) Reset() {
+	*x = WatchGamesRequest{}
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGamesRequest) ProtoMessage() {}
+
+func (x *WatchGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGamesRequest.ProtoReflect.Descriptor instead.
+func (*WatchGamesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchGamesRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+func (x *WatchGamesRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *WatchGamesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GameEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// created, updated or deleted. Deleted games carry their last state.
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	Game          *GameResponse          `protobuf:"bytes,4,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_games_grpc_games_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GameEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GameEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GameEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *GameEvent) GetGame() *GameResponse {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
 var File_internal_delivery_grpc_games_grpc_games_proto protoreflect.FileDescriptor
 
 const file_internal_delivery_grpc_games_grpc_games_proto_rawDesc = "" +
 	"\n" +
-	"-internal/delivery/grpc/games_grpc/games.proto\x12\x05games\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x1f\n" +
+	"-internal/delivery/grpc/games_grpc/games.proto\x12\x05games\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"9\n" +
 	"\rIdGameRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"p\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\xf7\x01\n" +
 	"\x11GameCreateRequest\x129\n" +
 	"\n" +
 	"game_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tgameStart\x12 \n" +
 	"\fgame_type_id\x18\x02 \x01(\tR\n" +
-	"gameTypeId\"z\n" +
+	"gameTypeId\x12#\n" +
+	"\rtournament_id\x18\x03 \x01(\tR\ftournamentId\x12\x14\n" +
+	"\x05round\x18\x04 \x01(\x05R\x05round\x12:\n" +
+	"\fparticipants\x18\x05 \x03(\v2\x16.games.GameParticipantR\fparticipants\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\tR\x02id\"\xfb\x02\n" +
 	"\vGameRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
 	"game_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tgameStart\x12 \n" +
 	"\fgame_type_id\x18\x03 \x01(\tR\n" +
-	"gameTypeId\"{\n" +
+	"gameTypeId\x12#\n" +
+	"\rtournament_id\x18\x04 \x01(\tR\ftournamentId\x12\x14\n" +
+	"\x05round\x18\x05 \x01(\x05R\x05round\x12:\n" +
+	"\fparticipants\x18\x06 \x03(\v2\x16.games.GameParticipantR\fparticipants\x121\n" +
+	"\x14replace_participants\x18\a \x01(\bR\x13replaceParticipants\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\"\x95\x03\n" +
 	"\fGameResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
 	"game_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tgameStart\x12 \n" +
 	"\fgame_type_id\x18\x03 \x01(\tR\n" +
-	"gameTypeId2\xf4\x01\n" +
+	"gameTypeId\x12#\n" +
+	"\rtournament_id\x18\x04 \x01(\tR\ftournamentId\x12\x14\n" +
+	"\x05round\x18\x05 \x01(\x05R\x05round\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12:\n" +
+	"\fparticipants\x18\a \x03(\v2\x16.games.GameParticipantR\fparticipants\x12\x18\n" +
+	"\abracket\x18\b \x01(\tR\abracket\x12\x14\n" +
+	"\x05group\x18\t \x01(\x05R\x05group\x12#\n" +
+	"\rplatform_name\x18\n" +
+	" \x01(\tR\fplatformName\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\"L\n" +
+	"\x0fGameParticipant\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x05R\x04slot\"\xda\x02\n" +
+	"\x10ListGamesRequest\x129\n" +
+	"\n" +
+	"start_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartFrom\x125\n" +
+	"\bstart_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartTo\x12 \n" +
+	"\fgame_type_id\x18\x03 \x01(\tR\n" +
+	"gameTypeId\x12#\n" +
+	"\rtournament_id\x18\x04 \x01(\tR\ftournamentId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\a \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\t \x01(\tR\tpageToken\"f\n" +
+	"\x11ListGamesResponse\x12)\n" +
+	"\x05games\x18\x01 \x03(\v2\x13.games.GameResponseR\x05games\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"A\n" +
+	"\x15GameTransitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"z\n" +
+	"\x13PostponeGameRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"game_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tgameStart\"v\n" +
+	"\x11WatchGamesRequest\x12#\n" +
+	"\rfrom_sequence\x18\x01 \x01(\x04R\ffromSequence\x12#\n" +
+	"\rtournament_id\x18\x02 \x01(\tR\ftournamentId\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\"\x90\x01\n" +
+	"\tGameEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12'\n" +
+	"\x04game\x18\x04 \x01(\v2\x13.games.GameResponseR\x04game2\xf1\x04\n" +
 	"\fGamesService\x126\n" +
 	"\tFetchById\x12\x14.games.IdGameRequest\x1a\x13.games.GameResponse\x12:\n" +
 	"\n" +
 	"DeleteById\x12\x14.games.IdGameRequest\x1a\x16.google.protobuf.Empty\x124\n" +
-	"\x06Update\x12\x12.games.GameRequest\x1a\x16.google.protobuf.Empty\x12:\n" +
-	"\x06Create\x12\x18.games.GameCreateRequest\x1a\x16.google.protobuf.EmptyB#Z!internal/delivery/grpc/games_grpcb\x06proto3"
+	"\x06Update\x12\x12.games.GameRequest\x1a\x16.google.protobuf.Empty\x127\n" +
+	"\x06Create\x12\x18.games.GameCreateRequest\x1a\x13.games.GameResponse\x12>\n" +
+	"\tListGames\x12\x17.games.ListGamesRequest\x1a\x18.games.ListGamesResponse\x12@\n" +
+	"\vOpenCheckIn\x12\x1c.games.GameTransitionRequest\x1a\x13.games.GameResponse\x12>\n" +
+	"\tStartGame\x12\x1c.games.GameTransitionRequest\x1a\x13.games.GameResponse\x12?\n" +
+	"\n" +
+	"CancelGame\x12\x1c.games.GameTransitionRequest\x1a\x13.games.GameResponse\x12?\n" +
+	"\fPostponeGame\x12\x1a.games.PostponeGameRequest\x1a\x13.games.GameResponse\x12:\n" +
+	"\n" +
+	"WatchGames\x12\x18.games.WatchGamesRequest\x1a\x10.games.GameEvent0\x01B#Z!internal/delivery/grpc/games_grpcb\x06proto3"
 
 var (
 	file_internal_delivery_grpc_games_grpc_games_proto_rawDescOnce sync.Once
@@ -282,32 +983,62 @@ func file_internal_delivery_grpc_games_grpc_games_proto_rawDescGZIP() []byte {
 	return file_internal_delivery_grpc_games_grpc_games_proto_rawDescData
 }
 
-var file_internal_delivery_grpc_games_grpc_games_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_internal_delivery_grpc_games_grpc_games_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_internal_delivery_grpc_games_grpc_games_proto_goTypes = []any{
 	(*IdGameRequest)(nil),         // 0: games.IdGameRequest
 	(*GameCreateRequest)(nil),     // 1: games.GameCreateRequest
 	(*GameRequest)(nil),           // 2: games.GameRequest
 	(*GameResponse)(nil),          // 3: games.GameResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 5: google.protobuf.Empty
+	(*GameParticipant)(nil),       // 4: games.GameParticipant
+	(*ListGamesRequest)(nil),      // 5: games.ListGamesRequest
+	(*ListGamesResponse)(nil),     // 6: games.ListGamesResponse
+	(*GameTransitionRequest)(nil), // 7: games.GameTransitionRequest
+	(*PostponeGameRequest)(nil),   // 8: games.PostponeGameRequest
+	(*WatchGamesRequest)(nil),     // 9: games.WatchGamesRequest
+	(*GameEvent)(nil),             // 10: games.GameEvent
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
 }
 var file_internal_delivery_grpc_games_grpc_games_proto_depIdxs = []int32{
-	4, // 0: games.GameCreateRequest.game_start:type_name -> google.protobuf.Timestamp
-	4, // 1: games.GameRequest.game_start:type_name -> google.protobuf.Timestamp
-	4, // 2: games.GameResponse.game_start:type_name -> google.protobuf.Timestamp
-	0, // 3: games.GamesService.FetchById:input_type -> games.IdGameRequest
-	0, // 4: games.GamesService.DeleteById:input_type -> games.IdGameRequest
-	2, // 5: games.GamesService.Update:input_type -> games.GameRequest
-	1, // 6: games.GamesService.Create:input_type -> games.GameCreateRequest
-	3, // 7: games.GamesService.FetchById:output_type -> games.GameResponse
-	5, // 8: games.GamesService.DeleteById:output_type -> google.protobuf.Empty
-	5, // 9: games.GamesService.Update:output_type -> google.protobuf.Empty
-	5, // 10: games.GamesService.Create:output_type -> google.protobuf.Empty
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: games.GameCreateRequest.game_start:type_name -> google.protobuf.Timestamp
+	4,  // 1: games.GameCreateRequest.participants:type_name -> games.GameParticipant
+	11, // 2: games.GameRequest.game_start:type_name -> google.protobuf.Timestamp
+	4,  // 3: games.GameRequest.participants:type_name -> games.GameParticipant
+	12, // 4: games.GameRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 5: games.GameResponse.game_start:type_name -> google.protobuf.Timestamp
+	4,  // 6: games.GameResponse.participants:type_name -> games.GameParticipant
+	11, // 7: games.ListGamesRequest.start_from:type_name -> google.protobuf.Timestamp
+	11, // 8: games.ListGamesRequest.start_to:type_name -> google.protobuf.Timestamp
+	3,  // 9: games.ListGamesResponse.games:type_name -> games.GameResponse
+	11, // 10: games.PostponeGameRequest.game_start:type_name -> google.protobuf.Timestamp
+	11, // 11: games.GameEvent.at:type_name -> google.protobuf.Timestamp
+	3,  // 12: games.GameEvent.game:type_name -> games.GameResponse
+	0,  // 13: games.GamesService.FetchById:input_type -> games.IdGameRequest
+	0,  // 14: games.GamesService.DeleteById:input_type -> games.IdGameRequest
+	2,  // 15: games.GamesService.Update:input_type -> games.GameRequest
+	1,  // 16: games.GamesService.Create:input_type -> games.GameCreateRequest
+	5,  // 17: games.GamesService.ListGames:input_type -> games.ListGamesRequest
+	7,  // 18: games.GamesService.OpenCheckIn:input_type -> games.GameTransitionRequest
+	7,  // 19: games.GamesService.StartGame:input_type -> games.GameTransitionRequest
+	7,  // 20: games.GamesService.CancelGame:input_type -> games.GameTransitionRequest
+	8,  // 21: games.GamesService.PostponeGame:input_type -> games.PostponeGameRequest
+	9,  // 22: games.GamesService.WatchGames:input_type -> games.WatchGamesRequest
+	3,  // 23: games.GamesService.FetchById:output_type -> games.GameResponse
+	13, // 24: games.GamesService.DeleteById:output_type -> google.protobuf.Empty
+	13, // 25: games.GamesService.Update:output_type -> google.protobuf.Empty
+	3,  // 26: games.GamesService.Create:output_type -> games.GameResponse
+	6,  // 27: games.GamesService.ListGames:output_type -> games.ListGamesResponse
+	3,  // 28: games.GamesService.OpenCheckIn:output_type -> games.GameResponse
+	3,  // 29: games.GamesService.StartGame:output_type -> games.GameResponse
+	3,  // 30: games.GamesService.CancelGame:output_type -> games.GameResponse
+	3,  // 31: games.GamesService.PostponeGame:output_type -> games.GameResponse
+	10, // 32: games.GamesService.WatchGames:output_type -> games.GameEvent
+	23, // [23:33] is the sub-list for method output_type
+	13, // [13:23] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_internal_delivery_grpc_games_grpc_games_proto_init() }
@@ -321,7 +1052,7 @@ func file_internal_delivery_grpc_games_grpc_games_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_games_grpc_games_proto_rawDesc), len(file_internal_delivery_grpc_games_grpc_games_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
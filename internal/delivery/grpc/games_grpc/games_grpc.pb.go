@@ -20,10 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GamesService_FetchById_FullMethodName  = "/games.GamesService/FetchById"
-	GamesService_DeleteById_FullMethodName = "/games.GamesService/DeleteById"
-	GamesService_Update_FullMethodName     = "/games.GamesService/Update"
-	GamesService_Create_FullMethodName     = "/games.GamesService/Create"
+	GamesService_FetchById_FullMethodName    = "/games.GamesService/FetchById"
+	GamesService_DeleteById_FullMethodName   = "/games.GamesService/DeleteById"
+	GamesService_Update_FullMethodName       = "/games.GamesService/Update"
+	GamesService_Create_FullMethodName       = "/games.GamesService/Create"
+	GamesService_ListGames_FullMethodName    = "/games.GamesService/ListGames"
+	GamesService_OpenCheckIn_FullMethodName  = "/games.GamesService/OpenCheckIn"
+	GamesService_StartGame_FullMethodName    = "/games.GamesService/StartGame"
+	GamesService_CancelGame_FullMethodName   = "/games.GamesService/CancelGame"
+	GamesService_PostponeGame_FullMethodName = "/games.GamesService/PostponeGame"
+	GamesService_WatchGames_FullMethodName   = "/games.GamesService/WatchGames"
 )
 
 // GamesServiceClient is the client API for GamesService service.
@@ -33,7 +39,13 @@ type GamesServiceClient interface {
 	FetchById(ctx context.Context, in *IdGameRequest, opts ...grpc.CallOption) (*GameResponse, error)
 	DeleteById(ctx context.Context, in *IdGameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Update(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Create(ctx context.Context, in *GameCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Create(ctx context.Context, in *GameCreateRequest, opts ...grpc.CallOption) (*GameResponse, error)
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	OpenCheckIn(ctx context.Context, in *GameTransitionRequest, opts ...grpc.CallOption) (*GameResponse, error)
+	StartGame(ctx context.Context, in *GameTransitionRequest, opts ...grpc.CallOption) (*GameResponse, error)
+	CancelGame(ctx context.Context, in *GameTransitionRequest, opts ...grpc.CallOption) (*GameResponse, error)
+	PostponeGame(ctx context.Context, in *PostponeGameRequest, opts ...grpc.CallOption) (*GameResponse, error)
+	WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error)
 }
 
 type gamesServiceClient struct {
@@ -74,9 +86,9 @@ func (c *gamesServiceClient) Update(ctx context.Context, in *GameRequest, opts .
 	return out, nil
 }
 
-func (c *gamesServiceClient) Create(ctx context.Context, in *GameCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *gamesServiceClient) Create(ctx context.Context, in *GameCreateRequest, opts ...grpc.CallOption) (*GameResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(GameResponse)
 	err := c.cc.Invoke(ctx, GamesService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -84,6 +96,75 @@ func (c *gamesServiceClient) Create(ctx context.Context, in *GameCreateRequest,
 	return out, nil
 }
 
+func (c *gamesServiceClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, GamesService_ListGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) OpenCheckIn(ctx context.Context, in *GameTransitionRequest, opts ...grpc.CallOption) (*GameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameResponse)
+	err := c.cc.Invoke(ctx, GamesService_OpenCheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) StartGame(ctx context.Context, in *GameTransitionRequest, opts ...grpc.CallOption) (*GameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameResponse)
+	err := c.cc.Invoke(ctx, GamesService_StartGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) CancelGame(ctx context.Context, in *GameTransitionRequest, opts ...grpc.CallOption) (*GameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameResponse)
+	err := c.cc.Invoke(ctx, GamesService_CancelGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) PostponeGame(ctx context.Context, in *PostponeGameRequest, opts ...grpc.CallOption) (*GameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameResponse)
+	err := c.cc.Invoke(ctx, GamesService_PostponeGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesServiceClient) WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GamesService_ServiceDesc.Streams[0], GamesService_WatchGames_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGamesRequest, GameEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GamesService_WatchGamesClient = grpc.ServerStreamingClient[GameEvent]
+
 // GamesServiceServer is the server API for GamesService service.
 // All implementations must embed UnimplementedGamesServiceServer
 // for forward compatibility.
@@ -91,7 +172,13 @@ type GamesServiceServer interface {
 	FetchById(context.Context, *IdGameRequest) (*GameResponse, error)
 	DeleteById(context.Context, *IdGameRequest) (*emptypb.Empty, error)
 	Update(context.Context, *GameRequest) (*emptypb.Empty, error)
-	Create(context.Context, *GameCreateRequest) (*emptypb.Empty, error)
+	Create(context.Context, *GameCreateRequest) (*GameResponse, error)
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	OpenCheckIn(context.Context, *GameTransitionRequest) (*GameResponse, error)
+	StartGame(context.Context, *GameTransitionRequest) (*GameResponse, error)
+	CancelGame(context.Context, *GameTransitionRequest) (*GameResponse, error)
+	PostponeGame(context.Context, *PostponeGameRequest) (*GameResponse, error)
+	WatchGames(*WatchGamesRequest, grpc.ServerStreamingServer[GameEvent]) error
 	mustEmbedUnimplementedGamesServiceServer()
 }
 
@@ -111,9 +198,27 @@ func (UnimplementedGamesServiceServer) DeleteById(context.Context, *IdGameReques
 func (UnimplementedGamesServiceServer) Update(context.Context, *GameRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedGamesServiceServer) Create(context.Context, *GameCreateRequest) (*emptypb.Empty, error) {
+func (UnimplementedGamesServiceServer) Create(context.Context, *GameCreateRequest) (*GameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedGamesServiceServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedGamesServiceServer) OpenCheckIn(context.Context, *GameTransitionRequest) (*GameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenCheckIn not implemented")
+}
+func (UnimplementedGamesServiceServer) StartGame(context.Context, *GameTransitionRequest) (*GameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedGamesServiceServer) CancelGame(context.Context, *GameTransitionRequest) (*GameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGame not implemented")
+}
+func (UnimplementedGamesServiceServer) PostponeGame(context.Context, *PostponeGameRequest) (*GameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostponeGame not implemented")
+}
+func (UnimplementedGamesServiceServer) WatchGames(*WatchGamesRequest, grpc.ServerStreamingServer[GameEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGames not implemented")
+}
 func (UnimplementedGamesServiceServer) mustEmbedUnimplementedGamesServiceServer() {}
 func (UnimplementedGamesServiceServer) testEmbeddedByValue()                      {}
 
@@ -207,6 +312,107 @@ func _GamesService_Create_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GamesService_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_ListGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_OpenCheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).OpenCheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_OpenCheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).OpenCheckIn(ctx, req.(*GameTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).StartGame(ctx, req.(*GameTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_CancelGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).CancelGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_CancelGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).CancelGame(ctx, req.(*GameTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_PostponeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostponeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServiceServer).PostponeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GamesService_PostponeGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServiceServer).PostponeGame(ctx, req.(*PostponeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GamesService_WatchGames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GamesServiceServer).WatchGames(m, &grpc.GenericServerStream[WatchGamesRequest, GameEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GamesService_WatchGamesServer = grpc.ServerStreamingServer[GameEvent]
+
 // GamesService_ServiceDesc is the grpc.ServiceDesc for GamesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,7 +436,33 @@ var GamesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _GamesService_Create_Handler,
 		},
+		{
+			MethodName: "ListGames",
+			Handler:    _GamesService_ListGames_Handler,
+		},
+		{
+			MethodName: "OpenCheckIn",
+			Handler:    _GamesService_OpenCheckIn_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _GamesService_StartGame_Handler,
+		},
+		{
+			MethodName: "CancelGame",
+			Handler:    _GamesService_CancelGame_Handler,
+		},
+		{
+			MethodName: "PostponeGame",
+			Handler:    _GamesService_PostponeGame_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGames",
+			Handler:       _GamesService_WatchGames_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/delivery/grpc/games_grpc/games.proto",
 }
@@ -19,12 +19,14 @@ import (
 type games_server struct {
 	games_grpc.UnimplementedGamesServiceServer
 	usecase usecase.GamesUseCase
+	events  usecase.EventsUseCase
 }
 
-func NewGamesGrpcServer(gserver *grpc.Server, rep *repository.GamesRepository) {
+func NewGamesGrpcServer(gserver *grpc.Server, rep *repository.GamesRepository, part_rep *repository.ParticipantsRepository, types_rep *repository.GameTypesRepository, grants_rep *repository.TournamentGrantsRepository, events usecase.EventsUseCase) {
 
 	gamesServer := &games_server{
-		usecase: usecase2.NewGamesUseCase(*rep, 10*time.Second),
+		usecase: usecase2.NewGamesUseCase(*rep, *part_rep, *types_rep, *grants_rep, 10*time.Second),
+		events:  events,
 	}
 
 	games_grpc.RegisterGamesServiceServer(gserver, gamesServer)
@@ -38,18 +40,108 @@ func (s games_server) FetchById(ctx context.Context, request *games_grpc.IdGameR
 
 	r, err := s.usecase.FetchById(ctx, uuid)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, err.Error())
+		return nil, toStatus(err)
 	}
 
+	return toGameResponse(r)
+}
+
+func toGameResponse(r models.Game) (*games_grpc.GameResponse, error) {
 	gameStartProto := timestamppb.New(r.GameStart)
 	if err := gameStartProto.CheckValid(); err != nil {
 		return nil, status.Errorf(codes.Internal, "invalid time: %v", err)
 	}
 
+	var tournamentId string
+	if r.TournamentID.Valid {
+		tournamentId = r.TournamentID.UUID.String()
+	}
+
+	participants := make([]*games_grpc.GameParticipant, 0, len(r.Participants))
+	for _, p := range r.Participants {
+		participants = append(participants, &games_grpc.GameParticipant{
+			ParticipantId: p.ParticipantID.String(),
+			Slot:          int32(p.Slot),
+		})
+	}
+
 	return &games_grpc.GameResponse{
-		Id:         uuid.String(),
-		GameStart:  gameStartProto,
-		GameTypeId: r.GameTypeID.String(),
+		Id:           r.GameID.String(),
+		GameStart:    gameStartProto,
+		GameTypeId:   r.GameTypeID.String(),
+		TournamentId: tournamentId,
+		Round:        int32(r.Round),
+		Position:     int32(r.Position),
+		Participants: participants,
+		Bracket:      string(r.Bracket),
+		Group:        int32(r.Group),
+		PlatformName: r.PlatformName,
+		Version:      r.Version,
+		Status:       string(r.Status),
+	}, nil
+}
+
+func (s games_server) ListGames(ctx context.Context, request *games_grpc.ListGamesRequest) (*games_grpc.ListGamesResponse, error) {
+	gameTypeUuid, err := parseNullUUID(request.GetGameTypeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tournamentUuid, err := parseNullUUID(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	gameStatus := models.GameStatus(request.GetStatus())
+	switch gameStatus {
+	case "", models.GameScheduled, models.GameCheckIn, models.GameLive,
+		models.GameFinished, models.GameCancelled, models.GamePostponed:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown game status %q", gameStatus)
+	}
+
+	sort := models.GameSort(request.GetOrderBy())
+	switch sort {
+	case "", models.GameSortStart, models.GameSortRound:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order %q", sort)
+	}
+
+	filter := models.GamesFilter{
+		GameTypeID:   gameTypeUuid,
+		TournamentID: tournamentUuid,
+		Status:       gameStatus,
+		Sort:         sort,
+		Descending:   request.GetDescending(),
+		Page: models.PageRequest{
+			Size:  int(request.GetPageSize()),
+			Token: request.GetPageToken(),
+		},
+	}
+	if request.StartFrom != nil {
+		filter.StartFrom = request.StartFrom.AsTime()
+	}
+	if request.StartTo != nil {
+		filter.StartTo = request.StartTo.AsTime()
+	}
+
+	page, err := s.usecase.List(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	games := make([]*games_grpc.GameResponse, 0, len(page.Games))
+	for _, g := range page.Games {
+		game, err := toGameResponse(g)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, game)
+	}
+
+	return &games_grpc.ListGamesResponse{
+		Games:         games,
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
@@ -59,9 +151,9 @@ func (s games_server) DeleteById(ctx context.Context, request *games_grpc.IdGame
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.usecase.DeleteById(ctx, uuid)
+	err = s.usecase.DeleteById(ctx, uuid, request.GetVersion())
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, err.Error())
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -73,41 +165,196 @@ func (s games_server) Update(ctx context.Context, request *games_grpc.GameReques
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	gameTypeUuid, err := uuid2.Parse(request.GetGameTypeId())
+	gameTypeUuid, err := parseNullUUID(request.GetGameTypeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tournamentUuid, err := parseNullUUID(request.GetTournamentId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
 	var game *models.Game
 	game = &models.Game{
-		GameID:     uuid,
-		GameStart:  request.GameStart.AsTime(),
-		GameTypeID: gameTypeUuid,
+		GameID:       uuid,
+		GameTypeID:   gameTypeUuid.UUID,
+		TournamentID: tournamentUuid,
+		Round:        int(request.GetRound()),
+		Version:      request.GetVersion(),
+	}
+	if request.GameStart != nil {
+		game.GameStart = request.GameStart.AsTime()
 	}
 
-	err = s.usecase.Update(ctx, game)
+	mask := models.UpdateMask(request.GetUpdateMask().GetPaths())
+	if request.GetReplaceParticipants() || mask.Has("participants") {
+		game.Participants, err = parseGameParticipants(request.GetParticipants())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err = s.usecase.Update(ctx, game, mask)
 	if err != nil {
-		return nil, status.Errorf(codes.Canceled, err.Error())
+		return nil, toStatus(err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s games_server) Create(ctx context.Context, request *games_grpc.GameCreateRequest) (*emptypb.Empty, error) {
+func (s games_server) Create(ctx context.Context, request *games_grpc.GameCreateRequest) (*games_grpc.GameResponse, error) {
 	var game *models.Game
 
+	gameId, err := parseNewID(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	gameTypeUuid, err := uuid2.Parse(request.GetGameTypeId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	tournamentUuid, err := parseNullUUID(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	participants, err := parseGameParticipants(request.GetParticipants())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	game = &models.Game{
-		GameID:     uuid2.New(),
-		GameStart:  request.GameStart.AsTime(),
-		GameTypeID: gameTypeUuid,
+		GameID:       gameId,
+		GameStart:    request.GameStart.AsTime(),
+		GameTypeID:   gameTypeUuid,
+		TournamentID: tournamentUuid,
+		Round:        int(request.GetRound()),
+		Participants: participants,
 	}
 	err = s.usecase.Create(ctx, game)
 	if err != nil {
-		return nil, status.Errorf(codes.Canceled, err.Error())
+		return nil, toStatus(err)
 	}
-	return &emptypb.Empty{}, nil
+
+	created, err := s.usecase.FetchById(ctx, gameId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toGameResponse(created)
+}
+
+// parseNewID lets clients pick the id of an entity they create, a new one is
+// generated when the id is empty.
+func parseNewID(s string) (uuid2.UUID, error) {
+	if s == "" {
+		return uuid2.New(), nil
+	}
+	return uuid2.Parse(s)
+}
+
+func (s games_server) OpenCheckIn(ctx context.Context, request *games_grpc.GameTransitionRequest) (*games_grpc.GameResponse, error) {
+	return s.transition(ctx, request, s.usecase.OpenCheckIn)
+}
+
+func (s games_server) StartGame(ctx context.Context, request *games_grpc.GameTransitionRequest) (*games_grpc.GameResponse, error) {
+	return s.transition(ctx, request, s.usecase.Start)
+}
+
+func (s games_server) CancelGame(ctx context.Context, request *games_grpc.GameTransitionRequest) (*games_grpc.GameResponse, error) {
+	return s.transition(ctx, request, s.usecase.Cancel)
+}
+
+func (s games_server) transition(ctx context.Context, request *games_grpc.GameTransitionRequest,
+	move func(context.Context, uuid2.UUID, int64) (models.Game, error)) (*games_grpc.GameResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	game, err := move(ctx, uuid, request.GetVersion())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toGameResponse(game)
+}
+
+func (s games_server) PostponeGame(ctx context.Context, request *games_grpc.PostponeGameRequest) (*games_grpc.GameResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var start time.Time
+	if request.GameStart != nil {
+		start = request.GameStart.AsTime()
+	}
+
+	game, err := s.usecase.Postpone(ctx, uuid, start, request.GetVersion())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toGameResponse(game)
+}
+
+func (s games_server) WatchGames(request *games_grpc.WatchGamesRequest, stream games_grpc.GamesService_WatchGamesServer) error {
+	tournamentUuid, err := parseNullUUID(request.GetTournamentId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	gameUuid, err := parseNullUUID(request.GetGameId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter := models.EventFilter{
+		Entity:       models.EntityGame,
+		GameID:       gameUuid,
+		TournamentID: tournamentUuid,
+	}
+	err = s.events.Watch(stream.Context(), filter, request.GetFromSequence(), func(e models.Event) error {
+		game, err := toGameResponse(*e.Game)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&games_grpc.GameEvent{
+			Sequence: e.Sequence,
+			Type:     string(e.Type),
+			At:       timestamppb.New(e.At),
+			Game:     game,
+		})
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+// parseNullUUID treats an empty string as an absent id.
+func parseNullUUID(s string) (uuid2.NullUUID, error) {
+	if s == "" {
+		return uuid2.NullUUID{}, nil
+	}
+
+	id, err := uuid2.Parse(s)
+	if err != nil {
+		return uuid2.NullUUID{}, err
+	}
+
+	return uuid2.NullUUID{UUID: id, Valid: true}, nil
+}
+
+// parseGameParticipants never returns nil so that an empty list clears the game.
+func parseGameParticipants(request []*games_grpc.GameParticipant) ([]models.GameParticipant, error) {
+	participants := make([]models.GameParticipant, 0, len(request))
+	for _, p := range request {
+		participantId, err := uuid2.Parse(p.GetParticipantId())
+		if err != nil {
+			return nil, err
+		}
+		participants = append(participants, models.GameParticipant{ParticipantID: participantId, Slot: int(p.GetSlot())})
+	}
+	return participants, nil
 }
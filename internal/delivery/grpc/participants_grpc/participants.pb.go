@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: internal/delivery/grpc/participants_grpc/participants.proto
+
+package participants_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IdParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdParticipantRequest) Reset() {
+	*x = IdParticipantRequest{}
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdParticipantRequest) ProtoMessage() {}
+
+func (x *IdParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdParticipantRequest.ProtoReflect.Descriptor instead.
+func (*IdParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IdParticipantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TeamMember) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ParticipantCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "player" or "team", players are the default.
+	Kind    string        `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Members []*TeamMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// The token subject of the account that reports results for the
+	// participant.
+	Subject       string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantCreateRequest) Reset() {
+	*x = ParticipantCreateRequest{}
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantCreateRequest) ProtoMessage() {}
+
+func (x *ParticipantCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantCreateRequest.ProtoReflect.Descriptor instead.
+func (*ParticipantCreateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParticipantCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParticipantCreateRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ParticipantCreateRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ParticipantCreateRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type ParticipantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind  string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The roster is replaced only when replace_members is set.
+	Members        []*TeamMember `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	ReplaceMembers bool          `protobuf:"varint,5,opt,name=replace_members,json=replaceMembers,proto3" json:"replace_members,omitempty"`
+	// Kept when empty.
+	Subject       string `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantRequest) Reset() {
+	*x = ParticipantRequest{}
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantRequest) ProtoMessage() {}
+
+func (x *ParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantRequest.ProtoReflect.Descriptor instead.
+func (*ParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParticipantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ParticipantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParticipantRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ParticipantRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ParticipantRequest) GetReplaceMembers() bool {
+	if x != nil {
+		return x.ReplaceMembers
+	}
+	return false
+}
+
+func (x *ParticipantRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type ParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	Subject       string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantResponse) Reset() {
+	*x = ParticipantResponse{}
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantResponse) ProtoMessage() {}
+
+func (x *ParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantResponse.ProtoReflect.Descriptor instead.
+func (*ParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ParticipantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ParticipantResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParticipantResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ParticipantResponse) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ParticipantResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+var File_internal_delivery_grpc_participants_grpc_participants_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_participants_grpc_participants_proto_rawDesc = "" +
+	"\n" +
+	";internal/delivery/grpc/participants_grpc/participants.proto\x12\fparticipants\x1a\x1bgoogle/protobuf/empty.proto\"&\n" +
+	"\x14IdParticipantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\n" +
+	"TeamMember\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x90\x01\n" +
+	"\x18ParticipantCreateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x122\n" +
+	"\amembers\x18\x03 \x03(\v2\x18.participants.TeamMemberR\amembers\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\"\xc3\x01\n" +
+	"\x12ParticipantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x122\n" +
+	"\amembers\x18\x04 \x03(\v2\x18.participants.TeamMemberR\amembers\x12'\n" +
+	"\x0freplace_members\x18\x05 \x01(\bR\x0ereplaceMembers\x12\x18\n" +
+	"\asubject\x18\x06 \x01(\tR\asubject\"\x9b\x01\n" +
+	"\x13ParticipantResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x122\n" +
+	"\amembers\x18\x04 \x03(\v2\x18.participants.TeamMemberR\amembers\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject2\xc1\x02\n" +
+	"\x13ParticipantsService\x12R\n" +
+	"\tFetchById\x12\".participants.IdParticipantRequest\x1a!.participants.ParticipantResponse\x12H\n" +
+	"\n" +
+	"DeleteById\x12\".participants.IdParticipantRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x06Update\x12 .participants.ParticipantRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x06Create\x12&.participants.ParticipantCreateRequest\x1a\x16.google.protobuf.EmptyB*Z(internal/delivery/grpc/participants_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_participants_grpc_participants_proto_rawDesc), len(file_internal_delivery_grpc_participants_grpc_participants_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_participants_grpc_participants_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_internal_delivery_grpc_participants_grpc_participants_proto_goTypes = []any{
+	(*IdParticipantRequest)(nil),     // 0: participants.IdParticipantRequest
+	(*TeamMember)(nil),               // 1: participants.TeamMember
+	(*ParticipantCreateRequest)(nil), // 2: participants.ParticipantCreateRequest
+	(*ParticipantRequest)(nil),       // 3: participants.ParticipantRequest
+	(*ParticipantResponse)(nil),      // 4: participants.ParticipantResponse
+	(*emptypb.Empty)(nil),            // 5: google.protobuf.Empty
+}
+var file_internal_delivery_grpc_participants_grpc_participants_proto_depIdxs = []int32{
+	1, // 0: participants.ParticipantCreateRequest.members:type_name -> participants.TeamMember
+	1, // 1: participants.ParticipantRequest.members:type_name -> participants.TeamMember
+	1, // 2: participants.ParticipantResponse.members:type_name -> participants.TeamMember
+	0, // 3: participants.ParticipantsService.FetchById:input_type -> participants.IdParticipantRequest
+	0, // 4: participants.ParticipantsService.DeleteById:input_type -> participants.IdParticipantRequest
+	3, // 5: participants.ParticipantsService.Update:input_type -> participants.ParticipantRequest
+	2, // 6: participants.ParticipantsService.Create:input_type -> participants.ParticipantCreateRequest
+	4, // 7: participants.ParticipantsService.FetchById:output_type -> participants.ParticipantResponse
+	5, // 8: participants.ParticipantsService.DeleteById:output_type -> google.protobuf.Empty
+	5, // 9: participants.ParticipantsService.Update:output_type -> google.protobuf.Empty
+	5, // 10: participants.ParticipantsService.Create:output_type -> google.protobuf.Empty
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_participants_grpc_participants_proto_init() }
+func file_internal_delivery_grpc_participants_grpc_participants_proto_init() {
+	if File_internal_delivery_grpc_participants_grpc_participants_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_participants_grpc_participants_proto_rawDesc), len(file_internal_delivery_grpc_participants_grpc_participants_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_delivery_grpc_participants_grpc_participants_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_grpc_participants_grpc_participants_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_grpc_participants_grpc_participants_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_grpc_participants_grpc_participants_proto = out.File
+	file_internal_delivery_grpc_participants_grpc_participants_proto_goTypes = nil
+	file_internal_delivery_grpc_participants_grpc_participants_proto_depIdxs = nil
+}
@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0--rc1
+// source: internal/delivery/grpc/participants_grpc/participants.proto
+
+package participants_grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ParticipantsService_FetchById_FullMethodName  = "/participants.ParticipantsService/FetchById"
+	ParticipantsService_DeleteById_FullMethodName = "/participants.ParticipantsService/DeleteById"
+	ParticipantsService_Update_FullMethodName     = "/participants.ParticipantsService/Update"
+	ParticipantsService_Create_FullMethodName     = "/participants.ParticipantsService/Create"
+)
+
+// ParticipantsServiceClient is the client API for ParticipantsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ParticipantsServiceClient interface {
+	FetchById(ctx context.Context, in *IdParticipantRequest, opts ...grpc.CallOption) (*ParticipantResponse, error)
+	DeleteById(ctx context.Context, in *IdParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Update(ctx context.Context, in *ParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Create(ctx context.Context, in *ParticipantCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type participantsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewParticipantsServiceClient(cc grpc.ClientConnInterface) ParticipantsServiceClient {
+	return &participantsServiceClient{cc}
+}
+
+func (c *participantsServiceClient) FetchById(ctx context.Context, in *IdParticipantRequest, opts ...grpc.CallOption) (*ParticipantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParticipantResponse)
+	err := c.cc.Invoke(ctx, ParticipantsService_FetchById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *participantsServiceClient) DeleteById(ctx context.Context, in *IdParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ParticipantsService_DeleteById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *participantsServiceClient) Update(ctx context.Context, in *ParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ParticipantsService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *participantsServiceClient) Create(ctx context.Context, in *ParticipantCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ParticipantsService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParticipantsServiceServer is the server API for ParticipantsService service.
+// All implementations must embed UnimplementedParticipantsServiceServer
+// for forward compatibility.
+type ParticipantsServiceServer interface {
+	FetchById(context.Context, *IdParticipantRequest) (*ParticipantResponse, error)
+	DeleteById(context.Context, *IdParticipantRequest) (*emptypb.Empty, error)
+	Update(context.Context, *ParticipantRequest) (*emptypb.Empty, error)
+	Create(context.Context, *ParticipantCreateRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedParticipantsServiceServer()
+}
+
+// UnimplementedParticipantsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedParticipantsServiceServer struct{}
+
+func (UnimplementedParticipantsServiceServer) FetchById(context.Context, *IdParticipantRequest) (*ParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchById not implemented")
+}
+func (UnimplementedParticipantsServiceServer) DeleteById(context.Context, *IdParticipantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteById not implemented")
+}
+func (UnimplementedParticipantsServiceServer) Update(context.Context, *ParticipantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedParticipantsServiceServer) Create(context.Context, *ParticipantCreateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedParticipantsServiceServer) mustEmbedUnimplementedParticipantsServiceServer() {}
+func (UnimplementedParticipantsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeParticipantsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ParticipantsServiceServer will
+// result in compilation errors.
+type UnsafeParticipantsServiceServer interface {
+	mustEmbedUnimplementedParticipantsServiceServer()
+}
+
+func RegisterParticipantsServiceServer(s grpc.ServiceRegistrar, srv ParticipantsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedParticipantsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ParticipantsService_ServiceDesc, srv)
+}
+
+func _ParticipantsService_FetchById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParticipantsServiceServer).FetchById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParticipantsService_FetchById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParticipantsServiceServer).FetchById(ctx, req.(*IdParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParticipantsService_DeleteById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParticipantsServiceServer).DeleteById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParticipantsService_DeleteById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParticipantsServiceServer).DeleteById(ctx, req.(*IdParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParticipantsService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParticipantsServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParticipantsService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParticipantsServiceServer).Update(ctx, req.(*ParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ParticipantsService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParticipantCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParticipantsServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ParticipantsService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParticipantsServiceServer).Create(ctx, req.(*ParticipantCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ParticipantsService_ServiceDesc is the grpc.ServiceDesc for ParticipantsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ParticipantsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "participants.ParticipantsService",
+	HandlerType: (*ParticipantsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchById",
+			Handler:    _ParticipantsService_FetchById_Handler,
+		},
+		{
+			MethodName: "DeleteById",
+			Handler:    _ParticipantsService_DeleteById_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ParticipantsService_Update_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ParticipantsService_Create_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/delivery/grpc/participants_grpc/participants.proto",
+}
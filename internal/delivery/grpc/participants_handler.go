@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	uuid2 "github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+	"tournaments-core/internal/delivery/grpc/participants_grpc"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+	usecase2 "tournaments-core/internal/usecase"
+)
+
+type participants_server struct {
+	participants_grpc.UnimplementedParticipantsServiceServer
+	usecase usecase.ParticipantsUseCase
+}
+
+func NewParticipantsGrpcServer(gserver *grpc.Server, rep *repository.ParticipantsRepository, grants_rep *repository.TournamentGrantsRepository) {
+
+	participantsServer := &participants_server{
+		usecase: usecase2.NewParticipantsUseCase(*rep, *grants_rep, 10*time.Second),
+	}
+
+	participants_grpc.RegisterParticipantsServiceServer(gserver, participantsServer)
+}
+
+func (s participants_server) FetchById(ctx context.Context, request *participants_grpc.IdParticipantRequest) (*participants_grpc.ParticipantResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.usecase.FetchById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	members := make([]*participants_grpc.TeamMember, 0, len(p.Members))
+	for _, m := range p.Members {
+		members = append(members, &participants_grpc.TeamMember{
+			PlayerId: m.PlayerID.String(),
+			Role:     m.Role,
+		})
+	}
+
+	return &participants_grpc.ParticipantResponse{
+		Id:      uuid.String(),
+		Name:    p.Name,
+		Kind:    string(p.Kind),
+		Subject: p.Subject,
+		Members: members,
+	}, nil
+}
+
+func (s participants_server) DeleteById(ctx context.Context, request *participants_grpc.IdParticipantRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.usecase.DeleteById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s participants_server) Update(ctx context.Context, request *participants_grpc.ParticipantRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var participant *models.Participant
+	participant = &models.Participant{
+		ParticipantID: uuid,
+		Name:          request.GetName(),
+		Kind:          models.ParticipantKind(request.GetKind()),
+		Subject:       request.GetSubject(),
+	}
+
+	if request.GetReplaceMembers() {
+		participant.Members, err = parseMembers(request.GetMembers())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err = s.usecase.Update(ctx, participant)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s participants_server) Create(ctx context.Context, request *participants_grpc.ParticipantCreateRequest) (*emptypb.Empty, error) {
+	members, err := parseMembers(request.GetMembers())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var participant *models.Participant
+	participant = &models.Participant{
+		ParticipantID: uuid2.New(),
+		Name:          request.GetName(),
+		Kind:          models.ParticipantKind(request.GetKind()),
+		Subject:       request.GetSubject(),
+		Members:       members,
+	}
+
+	err = s.usecase.Create(ctx, participant)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// parseMembers never returns nil so that an empty roster clears the team.
+func parseMembers(request []*participants_grpc.TeamMember) ([]models.TeamMember, error) {
+	members := make([]models.TeamMember, 0, len(request))
+	for _, m := range request {
+		playerId, err := uuid2.Parse(m.GetPlayerId())
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, models.TeamMember{PlayerID: playerId, Role: m.GetRole()})
+	}
+	return members, nil
+}
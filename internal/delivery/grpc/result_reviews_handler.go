@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+	uuid2 "github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"tournaments-core/internal/delivery/grpc/results_grpc"
+	"tournaments-core/internal/domain/models"
+)
+
+func (s res_server) ReportResult(ctx context.Context, request *results_grpc.ReportResultRequest) (*results_grpc.ResultReview, error) {
+	gameId, err := uuid2.Parse(request.GetGameId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	participantId, err := uuid2.Parse(request.GetParticipantId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	winnerId, placements, err := parseWinner(request.GetWinnerId(), request.GetPlacements())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	forfeitedBy, err := parseNullUUID(request.GetForfeitedBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	report := models.ResultReport{
+		GameID:        gameId,
+		ParticipantID: participantId,
+		WinnerID:      winnerId,
+		Placements:    placements,
+		Outcome:       fromOutcome(request.GetOutcome()),
+		ForfeitedBy:   forfeitedBy,
+		Comment:       request.GetComment(),
+	}
+	review, err := s.usecase.Report(ctx, &report)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toResultReview(review), nil
+}
+
+func (s res_server) FetchReview(ctx context.Context, request *results_grpc.IdGameRequest) (*results_grpc.ResultReview, error) {
+	gameId, err := uuid2.Parse(request.GetGameId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	review, err := s.usecase.Review(ctx, gameId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toResultReview(review), nil
+}
+
+func (s res_server) CommentReview(ctx context.Context, request *results_grpc.CommentReviewRequest) (*results_grpc.ReviewComment, error) {
+	gameId, err := uuid2.Parse(request.GetGameId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	comment := models.DisputeComment{
+		GameID: gameId,
+		Body:   request.GetBody(),
+	}
+	if err := s.usecase.Comment(ctx, &comment); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toReviewComment(comment), nil
+}
+
+func (s res_server) ResolveReview(ctx context.Context, request *results_grpc.ResolveReviewRequest) (*results_grpc.ResultReview, error) {
+	gameId, err := uuid2.Parse(request.GetGameId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	acceptReport, err := parseNullUUID(request.GetAcceptReportId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	winnerId, placements, err := parseWinner(request.GetWinnerId(), request.GetPlacements())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	forfeitedBy, err := parseNullUUID(request.GetForfeitedBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	result := models.Result{
+		GameID:      gameId,
+		WinnerID:    winnerId,
+		Comment:     request.GetComment(),
+		Placements:  placements,
+		Outcome:     fromOutcome(request.GetOutcome()),
+		ForfeitedBy: forfeitedBy,
+	}
+	review, err := s.usecase.Resolve(ctx, &result, acceptReport, request.GetResolution())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toResultReview(review), nil
+}
+
+func toResultReview(r models.ResultReview) *results_grpc.ResultReview {
+	var resultId string
+	if r.ResultID.Valid {
+		resultId = r.ResultID.UUID.String()
+	}
+
+	response := &results_grpc.ResultReview{
+		GameId:     r.GameID.String(),
+		Status:     string(r.Status),
+		ResultId:   resultId,
+		ResolvedBy: r.ResolvedBy,
+		Resolution: r.Resolution,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		UpdatedAt:  timestamppb.New(r.UpdatedAt),
+	}
+	for _, report := range r.Reports {
+		response.Reports = append(response.Reports, toResultReport(report))
+	}
+	for _, c := range r.Comments {
+		response.Comments = append(response.Comments, toReviewComment(c))
+	}
+	return response
+}
+
+func toResultReport(r models.ResultReport) *results_grpc.ResultReport {
+	var winnerId, forfeitedBy string
+	if r.WinnerID != uuid2.Nil {
+		winnerId = r.WinnerID.String()
+	}
+	if r.ForfeitedBy.Valid {
+		forfeitedBy = r.ForfeitedBy.UUID.String()
+	}
+
+	response := &results_grpc.ResultReport{
+		Id:            r.ReportID.String(),
+		GameId:        r.GameID.String(),
+		ParticipantId: r.ParticipantID.String(),
+		Subject:       r.Subject,
+		WinnerId:      winnerId,
+		Placements:    toPlacements(r.Placements),
+		Outcome:       toOutcome(r.Outcome),
+		ForfeitedBy:   forfeitedBy,
+		Comment:       r.Comment,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+	for _, a := range r.Attachments {
+		response.Attachments = append(response.Attachments, toAttachment(a))
+	}
+	return response
+}
+
+func toReviewComment(c models.DisputeComment) *results_grpc.ReviewComment {
+	return &results_grpc.ReviewComment{
+		Id:        c.CommentID.String(),
+		GameId:    c.GameID.String(),
+		Subject:   c.Subject,
+		Body:      c.Body,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+}
@@ -10,6 +10,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,9 +24,74 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Outcome int32
+
+const (
+	// Treated as a win.
+	Outcome_OUTCOME_UNSPECIFIED Outcome = 0
+	Outcome_OUTCOME_WIN         Outcome = 1
+	// Nobody wins, everyone is placed first with equal scores.
+	Outcome_OUTCOME_DRAW Outcome = 2
+	// forfeited_by names who forfeited, in a two-sided game the other side wins.
+	Outcome_OUTCOME_FORFEIT Outcome = 3
+	// forfeited_by names who was disqualified.
+	Outcome_OUTCOME_DISQUALIFICATION Outcome = 4
+	// The game was not played: no winner and no placements.
+	Outcome_OUTCOME_CANCELLED Outcome = 5
+)
+
+// Enum value maps for Outcome.
+var (
+	Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_WIN",
+		2: "OUTCOME_DRAW",
+		3: "OUTCOME_FORFEIT",
+		4: "OUTCOME_DISQUALIFICATION",
+		5: "OUTCOME_CANCELLED",
+	}
+	Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED":      0,
+		"OUTCOME_WIN":              1,
+		"OUTCOME_DRAW":             2,
+		"OUTCOME_FORFEIT":          3,
+		"OUTCOME_DISQUALIFICATION": 4,
+		"OUTCOME_CANCELLED":        5,
+	}
+)
+
+func (x Outcome) Enum() *Outcome {
+	p := new(Outcome)
+	*p = x
+	return p
+}
+
+func (x Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_delivery_grpc_results_grpc_results_proto_enumTypes[0].Descriptor()
+}
+
+func (Outcome) Type() protoreflect.EnumType {
+	return &file_internal_delivery_grpc_results_grpc_results_proto_enumTypes[0]
+}
+
+func (x Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Outcome.Descriptor instead.
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{0}
+}
+
 type IdResultRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only DeleteById looks at the version: when set it must match the stored one.
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,19 +133,140 @@ func (x *IdResultRequest) GetId() string {
 	return ""
 }
 
-type ResultResponse struct {
+func (x *IdResultRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type IdGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	WinnerId      string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdGameRequest) Reset() {
+	*x = IdGameRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdGameRequest) ProtoMessage() {}
+
+func (x *IdGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdGameRequest.ProtoReflect.Descriptor instead.
+func (*IdGameRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IdGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// Placement is where a participant finished and its score. Tied participants
+// share a place and the following places are skipped: 1, 2, 2, 4.
+type Placement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Place         int32                  `protobuf:"varint,2,opt,name=place,proto3" json:"place,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Placement) Reset() {
+	*x = Placement{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Placement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Placement) ProtoMessage() {}
+
+func (x *Placement) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Placement.ProtoReflect.Descriptor instead.
+func (*Placement) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Placement) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *Placement) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *Placement) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ResultResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The first participant placed first.
+	WinnerId   string       `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Comment    string       `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Placements []*Placement `protobuf:"bytes,5,rep,name=placements,proto3" json:"placements,omitempty"`
+	// Every participant placed first.
+	WinnerIds     []string               `protobuf:"bytes,6,rep,name=winner_ids,json=winnerIds,proto3" json:"winner_ids,omitempty"`
+	Outcome       Outcome                `protobuf:"varint,7,opt,name=outcome,proto3,enum=results.Outcome" json:"outcome,omitempty"`
+	ForfeitedBy   string                 `protobuf:"bytes,8,opt,name=forfeited_by,json=forfeitedBy,proto3" json:"forfeited_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Version       int64                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResultResponse) Reset() {
 	*x = ResultResponse{}
-	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[1]
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -90,7 +278,7 @@ func (x *ResultResponse) String() string {
 func (*ResultResponse) ProtoMessage() {}
 
 func (x *ResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[1]
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -103,7 +291,7 @@ func (x *ResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultResponse.ProtoReflect.Descriptor instead.
 func (*ResultResponse) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{1}
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ResultResponse) GetId() string {
@@ -134,19 +322,74 @@ func (x *ResultResponse) GetComment() string {
 	return ""
 }
 
+func (x *ResultResponse) GetPlacements() []*Placement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *ResultResponse) GetWinnerIds() []string {
+	if x != nil {
+		return x.WinnerIds
+	}
+	return nil
+}
+
+func (x *ResultResponse) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *ResultResponse) GetForfeitedBy() string {
+	if x != nil {
+		return x.ForfeitedBy
+	}
+	return ""
+}
+
+func (x *ResultResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ResultResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// winner_id may be left empty when placements are given or nobody won.
+// Without placements the winner is placed first and nobody else is placed.
 type ResultRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	WinnerId      string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId      string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WinnerId    string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Comment     string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Placements  []*Placement           `protobuf:"bytes,5,rep,name=placements,proto3" json:"placements,omitempty"`
+	Outcome     Outcome                `protobuf:"varint,6,opt,name=outcome,proto3,enum=results.Outcome" json:"outcome,omitempty"`
+	ForfeitedBy string                 `protobuf:"bytes,7,opt,name=forfeited_by,json=forfeitedBy,proto3" json:"forfeited_by,omitempty"`
+	// Fields to write: game_id, comment, winner_id, placements, outcome and
+	// forfeited_by. The last four describe how the game ended and are replaced
+	// together when any of them is named. Without a mask the whole result is
+	// written.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set it must match the stored version, otherwise the update is
+	// aborted.
+	Version       int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResultRequest) Reset() {
 	*x = ResultRequest{}
-	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[2]
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -158,7 +401,7 @@ func (x *ResultRequest) String() string {
 func (*ResultRequest) ProtoMessage() {}
 
 func (x *ResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[2]
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,7 +414,7 @@ func (x *ResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultRequest.ProtoReflect.Descriptor instead.
 func (*ResultRequest) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{2}
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ResultRequest) GetId() string {
@@ -202,18 +445,58 @@ func (x *ResultRequest) GetComment() string {
 	return ""
 }
 
+func (x *ResultRequest) GetPlacements() []*Placement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *ResultRequest) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *ResultRequest) GetForfeitedBy() string {
+	if x != nil {
+		return x.ForfeitedBy
+	}
+	return ""
+}
+
+func (x *ResultRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *ResultRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ResultCreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	WinnerId      string                 `protobuf:"bytes,2,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GameId      string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WinnerId    string                 `protobuf:"bytes,2,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Comment     string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Placements  []*Placement           `protobuf:"bytes,4,rep,name=placements,proto3" json:"placements,omitempty"`
+	Outcome     Outcome                `protobuf:"varint,5,opt,name=outcome,proto3,enum=results.Outcome" json:"outcome,omitempty"`
+	ForfeitedBy string                 `protobuf:"bytes,6,opt,name=forfeited_by,json=forfeitedBy,proto3" json:"forfeited_by,omitempty"`
+	// Optional client-chosen id, generated when empty.
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResultCreateRequest) Reset() {
 	*x = ResultCreateRequest{}
-	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[3]
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +508,7 @@ func (x *ResultCreateRequest) String() string {
 func (*ResultCreateRequest) ProtoMessage() {}
 
 func (x *ResultCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[3]
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +521,7 @@ func (x *ResultCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultCreateRequest.ProtoReflect.Descriptor instead.
 func (*ResultCreateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{3}
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResultCreateRequest) GetGameId() string {
@@ -262,87 +545,1746 @@ func (x *ResultCreateRequest) GetComment() string {
 	return ""
 }
 
-var File_internal_delivery_grpc_results_grpc_results_proto protoreflect.FileDescriptor
+func (x *ResultCreateRequest) GetPlacements() []*Placement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
 
-const file_internal_delivery_grpc_results_grpc_results_proto_rawDesc = "" +
-	"\n" +
-	"1internal/delivery/grpc/results_grpc/results.proto\x12\aresults\x1a\x1bgoogle/protobuf/empty.proto\"!\n" +
-	"\x0fIdResultRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"p\n" +
-	"\x0eResultResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
-	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\x12\x18\n" +
-	"\acomment\x18\x04 \x01(\tR\acomment\"o\n" +
-	"\rResultRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
-	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\x12\x18\n" +
-	"\acomment\x18\x04 \x01(\tR\acomment\"e\n" +
-	"\x13ResultCreateRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
-	"\twinner_id\x18\x02 \x01(\tR\bwinnerId\x12\x18\n" +
-	"\acomment\x18\x03 \x01(\tR\acomment2\x8a\x02\n" +
-	"\x0eResultsService\x12>\n" +
-	"\tFetchById\x12\x18.results.IdResultRequest\x1a\x17.results.ResultResponse\x12>\n" +
-	"\n" +
-	"DeleteById\x12\x18.results.IdResultRequest\x1a\x16.google.protobuf.Empty\x128\n" +
-	"\x06Update\x12\x16.results.ResultRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
-	"\x06Create\x12\x1c.results.ResultCreateRequest\x1a\x16.google.protobuf.EmptyB%Z#internal/delivery/grpc/results_grpcb\x06proto3"
+func (x *ResultCreateRequest) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
 
-var (
-	file_internal_delivery_grpc_results_grpc_results_proto_rawDescOnce sync.Once
-	file_internal_delivery_grpc_results_grpc_results_proto_rawDescData []byte
-)
+func (x *ResultCreateRequest) GetForfeitedBy() string {
+	if x != nil {
+		return x.ForfeitedBy
+	}
+	return ""
+}
 
-func file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP() []byte {
-	file_internal_delivery_grpc_results_grpc_results_proto_rawDescOnce.Do(func() {
-		file_internal_delivery_grpc_results_grpc_results_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_results_grpc_results_proto_rawDesc), len(file_internal_delivery_grpc_results_grpc_results_proto_rawDesc)))
-	})
-	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescData
+func (x *ResultCreateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-var file_internal_delivery_grpc_results_grpc_results_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_internal_delivery_grpc_results_grpc_results_proto_goTypes = []any{
-	(*IdResultRequest)(nil),     // 0: results.IdResultRequest
-	(*ResultResponse)(nil),      // 1: results.ResultResponse
-	(*ResultRequest)(nil),       // 2: results.ResultRequest
-	(*ResultCreateRequest)(nil), // 3: results.ResultCreateRequest
-	(*emptypb.Empty)(nil),       // 4: google.protobuf.Empty
+type ListResultsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Matches every participant placed first, draws are not wins.
+	WinnerId string `protobuf:"bytes,2,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	// Results created in [created_from, created_to), either bound may be omitted.
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Descending    bool                   `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-var file_internal_delivery_grpc_results_grpc_results_proto_depIdxs = []int32{
-	0, // 0: results.ResultsService.FetchById:input_type -> results.IdResultRequest
-	0, // 1: results.ResultsService.DeleteById:input_type -> results.IdResultRequest
-	2, // 2: results.ResultsService.Update:input_type -> results.ResultRequest
-	3, // 3: results.ResultsService.Create:input_type -> results.ResultCreateRequest
-	1, // 4: results.ResultsService.FetchById:output_type -> results.ResultResponse
-	4, // 5: results.ResultsService.DeleteById:output_type -> google.protobuf.Empty
-	4, // 6: results.ResultsService.Update:output_type -> google.protobuf.Empty
-	4, // 7: results.ResultsService.Create:output_type -> google.protobuf.Empty
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *ListResultsRequest) Reset() {
+	*x = ListResultsRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func init() { file_internal_delivery_grpc_results_grpc_results_proto_init() }
-func file_internal_delivery_grpc_results_grpc_results_proto_init() {
-	if File_internal_delivery_grpc_results_grpc_results_proto != nil {
-		return
+func (x *ListResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResultsRequest) ProtoMessage() {}
+
+func (x *ListResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListResultsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListResultsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ListResultsRequest) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *ListResultsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListResultsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListResultsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListResultsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResultsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ResultResponse      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResultsResponse) Reset() {
+	*x = ListResultsResponse{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResultsResponse) ProtoMessage() {}
+
+func (x *ListResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListResultsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListResultsResponse) GetResults() []*ResultResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListResultsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Streams result changes as they happen. Events after from_sequence are sent
+// first, zero starts with the next change. Sequences are outbox positions and
+// survive restarts of the service.
+type WatchResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromSequence  uint64                 `protobuf:"varint,1,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TournamentId  string                 `protobuf:"bytes,3,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResultsRequest) Reset() {
+	*x = WatchResultsRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResultsRequest) ProtoMessage() {}
+
+func (x *WatchResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResultsRequest.ProtoReflect.Descriptor instead.
+func (*WatchResultsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchResultsRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+func (x *WatchResultsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *WatchResultsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type ResultEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// created, updated or deleted. Deleted results carry their last state.
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	Result        *ResultResponse        `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultEvent) Reset() {
+	*x = ResultEvent{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultEvent) ProtoMessage() {}
+
+func (x *ResultEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultEvent.ProtoReflect.Descriptor instead.
+func (*ResultEvent) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResultEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ResultEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResultEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *ResultEvent) GetResult() *ResultResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Attachment is evidence backing a result: a screenshot, a replay file or a
+// link to a demo.
+type Attachment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResultId string                 `protobuf:"bytes,2,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	// screenshot, replay or demo_link.
+	Kind        string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// Hex SHA-256 of the file.
+	Sha256 string `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Only set for demo links.
+	Url        string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	UploadedBy string                 `protobuf:"bytes,9,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set instead of result_id for evidence attached to a report.
+	ReportId      string `protobuf:"bytes,11,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *Attachment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Attachment) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+// Exactly one of result_id and report_id is set. Participants attach
+// evidence to their own reports while the review is open.
+type AttachmentInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ResultId string                 `protobuf:"bytes,1,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	// screenshot or replay, screenshots need an image content type.
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ReportId      string `protobuf:"bytes,5,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentInfo) Reset() {
+	*x = AttachmentInfo{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentInfo) ProtoMessage() {}
+
+func (x *AttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AttachmentInfo) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentInfo {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type AddAttachmentLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultId      string                 `protobuf:"bytes,1,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ReportId      string                 `protobuf:"bytes,4,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAttachmentLinkRequest) Reset() {
+	*x = AddAttachmentLinkRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttachmentLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttachmentLinkRequest) ProtoMessage() {}
+
+func (x *AddAttachmentLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttachmentLinkRequest.ProtoReflect.Descriptor instead.
+func (*AddAttachmentLinkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddAttachmentLinkRequest) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *AddAttachmentLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddAttachmentLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAttachmentLinkRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type IdAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdAttachmentRequest) Reset() {
+	*x = IdAttachmentRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdAttachmentRequest) ProtoMessage() {}
+
+func (x *IdAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*IdAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IdAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadAttachmentResponse_Info
+	//	*DownloadAttachmentResponse_Chunk
+	Data          isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetInfo() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Info struct {
+	Info *Attachment `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Info) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+// The caller must sign in as the participant or as a member of its team. A
+// participant reporting again replaces its earlier report.
+type ReportResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	WinnerId      string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Placements    []*Placement           `protobuf:"bytes,5,rep,name=placements,proto3" json:"placements,omitempty"`
+	Outcome       Outcome                `protobuf:"varint,6,opt,name=outcome,proto3,enum=results.Outcome" json:"outcome,omitempty"`
+	ForfeitedBy   string                 `protobuf:"bytes,7,opt,name=forfeited_by,json=forfeitedBy,proto3" json:"forfeited_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportResultRequest) Reset() {
+	*x = ReportResultRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportResultRequest) ProtoMessage() {}
+
+func (x *ReportResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportResultRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReportResultRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReportResultRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ReportResultRequest) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *ReportResultRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReportResultRequest) GetPlacements() []*Placement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *ReportResultRequest) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *ReportResultRequest) GetForfeitedBy() string {
+	if x != nil {
+		return x.ForfeitedBy
+	}
+	return ""
+}
+
+type ResultReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	WinnerId      string                 `protobuf:"bytes,5,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Placements    []*Placement           `protobuf:"bytes,6,rep,name=placements,proto3" json:"placements,omitempty"`
+	Outcome       Outcome                `protobuf:"varint,7,opt,name=outcome,proto3,enum=results.Outcome" json:"outcome,omitempty"`
+	ForfeitedBy   string                 `protobuf:"bytes,8,opt,name=forfeited_by,json=forfeitedBy,proto3" json:"forfeited_by,omitempty"`
+	Comment       string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,11,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultReport) Reset() {
+	*x = ResultReport{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultReport) ProtoMessage() {}
+
+func (x *ResultReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultReport.ProtoReflect.Descriptor instead.
+func (*ResultReport) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResultReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResultReport) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ResultReport) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ResultReport) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ResultReport) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *ResultReport) GetPlacements() []*Placement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *ResultReport) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *ResultReport) GetForfeitedBy() string {
+	if x != nil {
+		return x.ForfeitedBy
+	}
+	return ""
+}
+
+func (x *ResultReport) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ResultReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ResultReport) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type ReviewComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewComment) Reset() {
+	*x = ReviewComment{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewComment) ProtoMessage() {}
+
+func (x *ReviewComment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewComment.ProtoReflect.Descriptor instead.
+func (*ReviewComment) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReviewComment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewComment) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReviewComment) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ReviewComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ReviewComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ResultReview struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// reported, confirmed, disputed or resolved.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The result a confirmed or resolved review wrote.
+	ResultId      string                 `protobuf:"bytes,3,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,4,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	Resolution    string                 `protobuf:"bytes,5,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Reports       []*ResultReport        `protobuf:"bytes,8,rep,name=reports,proto3" json:"reports,omitempty"`
+	Comments      []*ReviewComment       `protobuf:"bytes,9,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultReview) Reset() {
+	*x = ResultReview{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultReview) ProtoMessage() {}
+
+func (x *ResultReview) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultReview.ProtoReflect.Descriptor instead.
+func (*ResultReview) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResultReview) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ResultReview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ResultReview) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *ResultReview) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *ResultReview) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *ResultReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ResultReview) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ResultReview) GetReports() []*ResultReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ResultReview) GetComments() []*ReviewComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// Open reviews take comments from the participants of the game and its
+// officials.
+type CommentReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentReviewRequest) Reset() {
+	*x = CommentReviewRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentReviewRequest) ProtoMessage() {}
+
+func (x *CommentReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentReviewRequest.ProtoReflect.Descriptor instead.
+func (*CommentReviewRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CommentReviewRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CommentReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// A referee settles an open review either by accepting one of its reports or
+// with a result of their own, given like in ResultCreateRequest.
+type ResolveReviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameId         string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	AcceptReportId string                 `protobuf:"bytes,2,opt,name=accept_report_id,json=acceptReportId,proto3" json:"accept_report_id,omitempty"`
+	WinnerId       string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Comment        string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Placements     []*Placement           `protobuf:"bytes,5,rep,name=placements,proto3" json:"placements,omitempty"`
+	Outcome        Outcome                `protobuf:"varint,6,opt,name=outcome,proto3,enum=results.Outcome" json:"outcome,omitempty"`
+	ForfeitedBy    string                 `protobuf:"bytes,7,opt,name=forfeited_by,json=forfeitedBy,proto3" json:"forfeited_by,omitempty"`
+	Resolution     string                 `protobuf:"bytes,8,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolveReviewRequest) Reset() {
+	*x = ResolveReviewRequest{}
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReviewRequest) ProtoMessage() {}
+
+func (x *ResolveReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReviewRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResolveReviewRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ResolveReviewRequest) GetAcceptReportId() string {
+	if x != nil {
+		return x.AcceptReportId
+	}
+	return ""
+}
+
+func (x *ResolveReviewRequest) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *ResolveReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ResolveReviewRequest) GetPlacements() []*Placement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *ResolveReviewRequest) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *ResolveReviewRequest) GetForfeitedBy() string {
+	if x != nil {
+		return x.ForfeitedBy
+	}
+	return ""
+}
+
+func (x *ResolveReviewRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+var File_internal_delivery_grpc_results_grpc_results_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_results_grpc_results_proto_rawDesc = "" +
+	"\n" +
+	"1internal/delivery/grpc/results_grpc/results.proto\x12\aresults\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x0fIdResultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"(\n" +
+	"\rIdGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"^\n" +
+	"\tPlacement\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x14\n" +
+	"\x05place\x18\x02 \x01(\x05R\x05place\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"\xe7\x02\n" +
+	"\x0eResultResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x122\n" +
+	"\n" +
+	"placements\x18\x05 \x03(\v2\x12.results.PlacementR\n" +
+	"placements\x12\x1d\n" +
+	"\n" +
+	"winner_ids\x18\x06 \x03(\tR\twinnerIds\x12*\n" +
+	"\aoutcome\x18\a \x01(\x0e2\x10.results.OutcomeR\aoutcome\x12!\n" +
+	"\fforfeited_by\x18\b \x01(\tR\vforfeitedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\"\xc9\x02\n" +
+	"\rResultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x122\n" +
+	"\n" +
+	"placements\x18\x05 \x03(\v2\x12.results.PlacementR\n" +
+	"placements\x12*\n" +
+	"\aoutcome\x18\x06 \x01(\x0e2\x10.results.OutcomeR\aoutcome\x12!\n" +
+	"\fforfeited_by\x18\a \x01(\tR\vforfeitedBy\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\"\xf8\x01\n" +
+	"\x13ResultCreateRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\twinner_id\x18\x02 \x01(\tR\bwinnerId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x122\n" +
+	"\n" +
+	"placements\x18\x04 \x03(\v2\x12.results.PlacementR\n" +
+	"placements\x12*\n" +
+	"\aoutcome\x18\x05 \x01(\x0e2\x10.results.OutcomeR\aoutcome\x12!\n" +
+	"\fforfeited_by\x18\x06 \x01(\tR\vforfeitedBy\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\"\xa0\x02\n" +
+	"\x12ListResultsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\twinner_id\x18\x02 \x01(\tR\bwinnerId\x12=\n" +
+	"\fcreated_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x05 \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"p\n" +
+	"\x13ListResultsResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.results.ResultResponseR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"x\n" +
+	"\x13WatchResultsRequest\x12#\n" +
+	"\rfrom_sequence\x18\x01 \x01(\x04R\ffromSequence\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12#\n" +
+	"\rtournament_id\x18\x03 \x01(\tR\ftournamentId\"\x9a\x01\n" +
+	"\vResultEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12/\n" +
+	"\x06result\x18\x04 \x01(\v2\x17.results.ResultResponseR\x06result\"\xbb\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tresult_id\x18\x02 \x01(\tR\bresultId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12\x1f\n" +
+	"\vuploaded_by\x18\t \x01(\tR\n" +
+	"uploadedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\treport_id\x18\v \x01(\tR\breportId\"\x95\x01\n" +
+	"\x0eAttachmentInfo\x12\x1b\n" +
+	"\tresult_id\x18\x01 \x01(\tR\bresultId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\treport_id\x18\x05 \x01(\tR\breportId\"h\n" +
+	"\x17UploadAttachmentRequest\x12-\n" +
+	"\x04info\x18\x01 \x01(\v2\x17.results.AttachmentInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"z\n" +
+	"\x18AddAttachmentLinkRequest\x12\x1b\n" +
+	"\tresult_id\x18\x01 \x01(\tR\bresultId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\treport_id\x18\x04 \x01(\tR\breportId\"%\n" +
+	"\x13IdAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x17ListAttachmentsResponse\x125\n" +
+	"\vattachments\x18\x01 \x03(\v2\x13.results.AttachmentR\vattachments\"g\n" +
+	"\x1aDownloadAttachmentResponse\x12)\n" +
+	"\x04info\x18\x01 \x01(\v2\x13.results.AttachmentH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\x8f\x02\n" +
+	"\x13ReportResultRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x1b\n" +
+	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x122\n" +
+	"\n" +
+	"placements\x18\x05 \x03(\v2\x12.results.PlacementR\n" +
+	"placements\x12*\n" +
+	"\aoutcome\x18\x06 \x01(\x0e2\x10.results.OutcomeR\aoutcome\x12!\n" +
+	"\fforfeited_by\x18\a \x01(\tR\vforfeitedBy\"\xa4\x03\n" +
+	"\fResultReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12%\n" +
+	"\x0eparticipant_id\x18\x03 \x01(\tR\rparticipantId\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x1b\n" +
+	"\twinner_id\x18\x05 \x01(\tR\bwinnerId\x122\n" +
+	"\n" +
+	"placements\x18\x06 \x03(\v2\x12.results.PlacementR\n" +
+	"placements\x12*\n" +
+	"\aoutcome\x18\a \x01(\x0e2\x10.results.OutcomeR\aoutcome\x12!\n" +
+	"\fforfeited_by\x18\b \x01(\tR\vforfeitedBy\x12\x18\n" +
+	"\acomment\x18\t \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
+	"\vattachments\x18\v \x03(\v2\x13.results.AttachmentR\vattachments\"\xa1\x01\n" +
+	"\rReviewComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf8\x02\n" +
+	"\fResultReview\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tresult_id\x18\x03 \x01(\tR\bresultId\x12\x1f\n" +
+	"\vresolved_by\x18\x04 \x01(\tR\n" +
+	"resolvedBy\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x05 \x01(\tR\n" +
+	"resolution\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\areports\x18\b \x03(\v2\x15.results.ResultReportR\areports\x122\n" +
+	"\bcomments\x18\t \x03(\v2\x16.results.ReviewCommentR\bcomments\"C\n" +
+	"\x14CommentReviewRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"\xb3\x02\n" +
+	"\x14ResolveReviewRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12(\n" +
+	"\x10accept_report_id\x18\x02 \x01(\tR\x0eacceptReportId\x12\x1b\n" +
+	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x122\n" +
+	"\n" +
+	"placements\x18\x05 \x03(\v2\x12.results.PlacementR\n" +
+	"placements\x12*\n" +
+	"\aoutcome\x18\x06 \x01(\x0e2\x10.results.OutcomeR\aoutcome\x12!\n" +
+	"\fforfeited_by\x18\a \x01(\tR\vforfeitedBy\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\b \x01(\tR\n" +
+	"resolution*\x8f\x01\n" +
+	"\aOutcome\x12\x17\n" +
+	"\x13OUTCOME_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vOUTCOME_WIN\x10\x01\x12\x10\n" +
+	"\fOUTCOME_DRAW\x10\x02\x12\x13\n" +
+	"\x0fOUTCOME_FORFEIT\x10\x03\x12\x1c\n" +
+	"\x18OUTCOME_DISQUALIFICATION\x10\x04\x12\x15\n" +
+	"\x11OUTCOME_CANCELLED\x10\x052\xfd\b\n" +
+	"\x0eResultsService\x12>\n" +
+	"\tFetchById\x12\x18.results.IdResultRequest\x1a\x17.results.ResultResponse\x12>\n" +
+	"\n" +
+	"DeleteById\x12\x18.results.IdResultRequest\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\x06Update\x12\x16.results.ResultRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\x06Create\x12\x1c.results.ResultCreateRequest\x1a\x17.results.ResultResponse\x12@\n" +
+	"\rFetchByGameId\x12\x16.results.IdGameRequest\x1a\x17.results.ResultResponse\x12H\n" +
+	"\vListResults\x12\x1b.results.ListResultsRequest\x1a\x1c.results.ListResultsResponse\x12D\n" +
+	"\fWatchResults\x12\x1c.results.WatchResultsRequest\x1a\x14.results.ResultEvent0\x01\x12K\n" +
+	"\x10UploadAttachment\x12 .results.UploadAttachmentRequest\x1a\x13.results.Attachment(\x01\x12K\n" +
+	"\x11AddAttachmentLink\x12!.results.AddAttachmentLinkRequest\x1a\x13.results.Attachment\x12M\n" +
+	"\x0fListAttachments\x12\x18.results.IdResultRequest\x1a .results.ListAttachmentsResponse\x12Y\n" +
+	"\x12DownloadAttachment\x12\x1c.results.IdAttachmentRequest\x1a#.results.DownloadAttachmentResponse0\x01\x12H\n" +
+	"\x10DeleteAttachment\x12\x1c.results.IdAttachmentRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\fReportResult\x12\x1c.results.ReportResultRequest\x1a\x15.results.ResultReview\x12<\n" +
+	"\vFetchReview\x12\x16.results.IdGameRequest\x1a\x15.results.ResultReview\x12F\n" +
+	"\rCommentReview\x12\x1d.results.CommentReviewRequest\x1a\x16.results.ReviewComment\x12E\n" +
+	"\rResolveReview\x12\x1d.results.ResolveReviewRequest\x1a\x15.results.ResultReviewB%Z#internal/delivery/grpc/results_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_results_grpc_results_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_results_grpc_results_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_results_grpc_results_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_results_grpc_results_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_results_grpc_results_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_results_grpc_results_proto_rawDesc), len(file_internal_delivery_grpc_results_grpc_results_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_results_grpc_results_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_results_grpc_results_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_delivery_grpc_results_grpc_results_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_internal_delivery_grpc_results_grpc_results_proto_goTypes = []any{
+	(Outcome)(0),                       // 0: results.Outcome
+	(*IdResultRequest)(nil),            // 1: results.IdResultRequest
+	(*IdGameRequest)(nil),              // 2: results.IdGameRequest
+	(*Placement)(nil),                  // 3: results.Placement
+	(*ResultResponse)(nil),             // 4: results.ResultResponse
+	(*ResultRequest)(nil),              // 5: results.ResultRequest
+	(*ResultCreateRequest)(nil),        // 6: results.ResultCreateRequest
+	(*ListResultsRequest)(nil),         // 7: results.ListResultsRequest
+	(*ListResultsResponse)(nil),        // 8: results.ListResultsResponse
+	(*WatchResultsRequest)(nil),        // 9: results.WatchResultsRequest
+	(*ResultEvent)(nil),                // 10: results.ResultEvent
+	(*Attachment)(nil),                 // 11: results.Attachment
+	(*AttachmentInfo)(nil),             // 12: results.AttachmentInfo
+	(*UploadAttachmentRequest)(nil),    // 13: results.UploadAttachmentRequest
+	(*AddAttachmentLinkRequest)(nil),   // 14: results.AddAttachmentLinkRequest
+	(*IdAttachmentRequest)(nil),        // 15: results.IdAttachmentRequest
+	(*ListAttachmentsResponse)(nil),    // 16: results.ListAttachmentsResponse
+	(*DownloadAttachmentResponse)(nil), // 17: results.DownloadAttachmentResponse
+	(*ReportResultRequest)(nil),        // 18: results.ReportResultRequest
+	(*ResultReport)(nil),               // 19: results.ResultReport
+	(*ReviewComment)(nil),              // 20: results.ReviewComment
+	(*ResultReview)(nil),               // 21: results.ResultReview
+	(*CommentReviewRequest)(nil),       // 22: results.CommentReviewRequest
+	(*ResolveReviewRequest)(nil),       // 23: results.ResolveReviewRequest
+	(*timestamppb.Timestamp)(nil),      // 24: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 25: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),              // 26: google.protobuf.Empty
+}
+var file_internal_delivery_grpc_results_grpc_results_proto_depIdxs = []int32{
+	3,  // 0: results.ResultResponse.placements:type_name -> results.Placement
+	0,  // 1: results.ResultResponse.outcome:type_name -> results.Outcome
+	24, // 2: results.ResultResponse.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 3: results.ResultRequest.placements:type_name -> results.Placement
+	0,  // 4: results.ResultRequest.outcome:type_name -> results.Outcome
+	25, // 5: results.ResultRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 6: results.ResultCreateRequest.placements:type_name -> results.Placement
+	0,  // 7: results.ResultCreateRequest.outcome:type_name -> results.Outcome
+	24, // 8: results.ListResultsRequest.created_from:type_name -> google.protobuf.Timestamp
+	24, // 9: results.ListResultsRequest.created_to:type_name -> google.protobuf.Timestamp
+	4,  // 10: results.ListResultsResponse.results:type_name -> results.ResultResponse
+	24, // 11: results.ResultEvent.at:type_name -> google.protobuf.Timestamp
+	4,  // 12: results.ResultEvent.result:type_name -> results.ResultResponse
+	24, // 13: results.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	12, // 14: results.UploadAttachmentRequest.info:type_name -> results.AttachmentInfo
+	11, // 15: results.ListAttachmentsResponse.attachments:type_name -> results.Attachment
+	11, // 16: results.DownloadAttachmentResponse.info:type_name -> results.Attachment
+	3,  // 17: results.ReportResultRequest.placements:type_name -> results.Placement
+	0,  // 18: results.ReportResultRequest.outcome:type_name -> results.Outcome
+	3,  // 19: results.ResultReport.placements:type_name -> results.Placement
+	0,  // 20: results.ResultReport.outcome:type_name -> results.Outcome
+	24, // 21: results.ResultReport.created_at:type_name -> google.protobuf.Timestamp
+	11, // 22: results.ResultReport.attachments:type_name -> results.Attachment
+	24, // 23: results.ReviewComment.created_at:type_name -> google.protobuf.Timestamp
+	24, // 24: results.ResultReview.created_at:type_name -> google.protobuf.Timestamp
+	24, // 25: results.ResultReview.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 26: results.ResultReview.reports:type_name -> results.ResultReport
+	20, // 27: results.ResultReview.comments:type_name -> results.ReviewComment
+	3,  // 28: results.ResolveReviewRequest.placements:type_name -> results.Placement
+	0,  // 29: results.ResolveReviewRequest.outcome:type_name -> results.Outcome
+	1,  // 30: results.ResultsService.FetchById:input_type -> results.IdResultRequest
+	1,  // 31: results.ResultsService.DeleteById:input_type -> results.IdResultRequest
+	5,  // 32: results.ResultsService.Update:input_type -> results.ResultRequest
+	6,  // 33: results.ResultsService.Create:input_type -> results.ResultCreateRequest
+	2,  // 34: results.ResultsService.FetchByGameId:input_type -> results.IdGameRequest
+	7,  // 35: results.ResultsService.ListResults:input_type -> results.ListResultsRequest
+	9,  // 36: results.ResultsService.WatchResults:input_type -> results.WatchResultsRequest
+	13, // 37: results.ResultsService.UploadAttachment:input_type -> results.UploadAttachmentRequest
+	14, // 38: results.ResultsService.AddAttachmentLink:input_type -> results.AddAttachmentLinkRequest
+	1,  // 39: results.ResultsService.ListAttachments:input_type -> results.IdResultRequest
+	15, // 40: results.ResultsService.DownloadAttachment:input_type -> results.IdAttachmentRequest
+	15, // 41: results.ResultsService.DeleteAttachment:input_type -> results.IdAttachmentRequest
+	18, // 42: results.ResultsService.ReportResult:input_type -> results.ReportResultRequest
+	2,  // 43: results.ResultsService.FetchReview:input_type -> results.IdGameRequest
+	22, // 44: results.ResultsService.CommentReview:input_type -> results.CommentReviewRequest
+	23, // 45: results.ResultsService.ResolveReview:input_type -> results.ResolveReviewRequest
+	4,  // 46: results.ResultsService.FetchById:output_type -> results.ResultResponse
+	26, // 47: results.ResultsService.DeleteById:output_type -> google.protobuf.Empty
+	26, // 48: results.ResultsService.Update:output_type -> google.protobuf.Empty
+	4,  // 49: results.ResultsService.Create:output_type -> results.ResultResponse
+	4,  // 50: results.ResultsService.FetchByGameId:output_type -> results.ResultResponse
+	8,  // 51: results.ResultsService.ListResults:output_type -> results.ListResultsResponse
+	10, // 52: results.ResultsService.WatchResults:output_type -> results.ResultEvent
+	11, // 53: results.ResultsService.UploadAttachment:output_type -> results.Attachment
+	11, // 54: results.ResultsService.AddAttachmentLink:output_type -> results.Attachment
+	16, // 55: results.ResultsService.ListAttachments:output_type -> results.ListAttachmentsResponse
+	17, // 56: results.ResultsService.DownloadAttachment:output_type -> results.DownloadAttachmentResponse
+	26, // 57: results.ResultsService.DeleteAttachment:output_type -> google.protobuf.Empty
+	21, // 58: results.ResultsService.ReportResult:output_type -> results.ResultReview
+	21, // 59: results.ResultsService.FetchReview:output_type -> results.ResultReview
+	20, // 60: results.ResultsService.CommentReview:output_type -> results.ReviewComment
+	21, // 61: results.ResultsService.ResolveReview:output_type -> results.ResultReview
+	46, // [46:62] is the sub-list for method output_type
+	30, // [30:46] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_results_grpc_results_proto_init() }
+func file_internal_delivery_grpc_results_grpc_results_proto_init() {
+	if File_internal_delivery_grpc_results_grpc_results_proto != nil {
+		return
+	}
+	file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[12].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_internal_delivery_grpc_results_grpc_results_proto_msgTypes[16].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Info)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_results_grpc_results_proto_rawDesc), len(file_internal_delivery_grpc_results_grpc_results_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_internal_delivery_grpc_results_grpc_results_proto_goTypes,
 		DependencyIndexes: file_internal_delivery_grpc_results_grpc_results_proto_depIdxs,
+		EnumInfos:         file_internal_delivery_grpc_results_grpc_results_proto_enumTypes,
 		MessageInfos:      file_internal_delivery_grpc_results_grpc_results_proto_msgTypes,
 	}.Build()
 	File_internal_delivery_grpc_results_grpc_results_proto = out.File
@@ -20,10 +20,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ResultsService_FetchById_FullMethodName  = "/results.ResultsService/FetchById"
-	ResultsService_DeleteById_FullMethodName = "/results.ResultsService/DeleteById"
-	ResultsService_Update_FullMethodName     = "/results.ResultsService/Update"
-	ResultsService_Create_FullMethodName     = "/results.ResultsService/Create"
+	ResultsService_FetchById_FullMethodName          = "/results.ResultsService/FetchById"
+	ResultsService_DeleteById_FullMethodName         = "/results.ResultsService/DeleteById"
+	ResultsService_Update_FullMethodName             = "/results.ResultsService/Update"
+	ResultsService_Create_FullMethodName             = "/results.ResultsService/Create"
+	ResultsService_FetchByGameId_FullMethodName      = "/results.ResultsService/FetchByGameId"
+	ResultsService_ListResults_FullMethodName        = "/results.ResultsService/ListResults"
+	ResultsService_WatchResults_FullMethodName       = "/results.ResultsService/WatchResults"
+	ResultsService_UploadAttachment_FullMethodName   = "/results.ResultsService/UploadAttachment"
+	ResultsService_AddAttachmentLink_FullMethodName  = "/results.ResultsService/AddAttachmentLink"
+	ResultsService_ListAttachments_FullMethodName    = "/results.ResultsService/ListAttachments"
+	ResultsService_DownloadAttachment_FullMethodName = "/results.ResultsService/DownloadAttachment"
+	ResultsService_DeleteAttachment_FullMethodName   = "/results.ResultsService/DeleteAttachment"
+	ResultsService_ReportResult_FullMethodName       = "/results.ResultsService/ReportResult"
+	ResultsService_FetchReview_FullMethodName        = "/results.ResultsService/FetchReview"
+	ResultsService_CommentReview_FullMethodName      = "/results.ResultsService/CommentReview"
+	ResultsService_ResolveReview_FullMethodName      = "/results.ResultsService/ResolveReview"
 )
 
 // ResultsServiceClient is the client API for ResultsService service.
@@ -33,7 +45,27 @@ type ResultsServiceClient interface {
 	FetchById(ctx context.Context, in *IdResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
 	DeleteById(ctx context.Context, in *IdResultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Update(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Create(ctx context.Context, in *ResultCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Create(ctx context.Context, in *ResultCreateRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	FetchByGameId(ctx context.Context, in *IdGameRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	ListResults(ctx context.Context, in *ListResultsRequest, opts ...grpc.CallOption) (*ListResultsResponse, error)
+	WatchResults(ctx context.Context, in *WatchResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultEvent], error)
+	// The first message describes the file, the ones after it carry its
+	// content. Files are limited to 64 MiB.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error)
+	AddAttachmentLink(ctx context.Context, in *AddAttachmentLinkRequest, opts ...grpc.CallOption) (*Attachment, error)
+	ListAttachments(ctx context.Context, in *IdResultRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// The first message describes the file, the ones after it carry its
+	// content. Demo links have no content and fail with FAILED_PRECONDITION.
+	DownloadAttachment(ctx context.Context, in *IdAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	DeleteAttachment(ctx context.Context, in *IdAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Participants report results themselves. Once every participant of the
+	// game has reported, matching reports confirm the result and differing
+	// ones open a dispute a referee resolves with ResolveReview. Only
+	// confirmed and resolved reviews write a result.
+	ReportResult(ctx context.Context, in *ReportResultRequest, opts ...grpc.CallOption) (*ResultReview, error)
+	FetchReview(ctx context.Context, in *IdGameRequest, opts ...grpc.CallOption) (*ResultReview, error)
+	CommentReview(ctx context.Context, in *CommentReviewRequest, opts ...grpc.CallOption) (*ReviewComment, error)
+	ResolveReview(ctx context.Context, in *ResolveReviewRequest, opts ...grpc.CallOption) (*ResultReview, error)
 }
 
 type resultsServiceClient struct {
@@ -74,9 +106,9 @@ func (c *resultsServiceClient) Update(ctx context.Context, in *ResultRequest, op
 	return out, nil
 }
 
-func (c *resultsServiceClient) Create(ctx context.Context, in *ResultCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *resultsServiceClient) Create(ctx context.Context, in *ResultCreateRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(ResultResponse)
 	err := c.cc.Invoke(ctx, ResultsService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -84,6 +116,147 @@ func (c *resultsServiceClient) Create(ctx context.Context, in *ResultCreateReque
 	return out, nil
 }
 
+func (c *resultsServiceClient) FetchByGameId(ctx context.Context, in *IdGameRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultResponse)
+	err := c.cc.Invoke(ctx, ResultsService_FetchByGameId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) ListResults(ctx context.Context, in *ListResultsRequest, opts ...grpc.CallOption) (*ListResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResultsResponse)
+	err := c.cc.Invoke(ctx, ResultsService_ListResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) WatchResults(ctx context.Context, in *WatchResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResultsService_ServiceDesc.Streams[0], ResultsService_WatchResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchResultsRequest, ResultEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultsService_WatchResultsClient = grpc.ServerStreamingClient[ResultEvent]
+
+func (c *resultsServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResultsService_ServiceDesc.Streams[1], ResultsService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, Attachment]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultsService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment]
+
+func (c *resultsServiceClient) AddAttachmentLink(ctx context.Context, in *AddAttachmentLinkRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, ResultsService_AddAttachmentLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) ListAttachments(ctx context.Context, in *IdResultRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, ResultsService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) DownloadAttachment(ctx context.Context, in *IdAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResultsService_ServiceDesc.Streams[2], ResultsService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IdAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultsService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *resultsServiceClient) DeleteAttachment(ctx context.Context, in *IdAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResultsService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) ReportResult(ctx context.Context, in *ReportResultRequest, opts ...grpc.CallOption) (*ResultReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReview)
+	err := c.cc.Invoke(ctx, ResultsService_ReportResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) FetchReview(ctx context.Context, in *IdGameRequest, opts ...grpc.CallOption) (*ResultReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReview)
+	err := c.cc.Invoke(ctx, ResultsService_FetchReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) CommentReview(ctx context.Context, in *CommentReviewRequest, opts ...grpc.CallOption) (*ReviewComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewComment)
+	err := c.cc.Invoke(ctx, ResultsService_CommentReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultsServiceClient) ResolveReview(ctx context.Context, in *ResolveReviewRequest, opts ...grpc.CallOption) (*ResultReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReview)
+	err := c.cc.Invoke(ctx, ResultsService_ResolveReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResultsServiceServer is the server API for ResultsService service.
 // All implementations must embed UnimplementedResultsServiceServer
 // for forward compatibility.
@@ -91,7 +264,27 @@ type ResultsServiceServer interface {
 	FetchById(context.Context, *IdResultRequest) (*ResultResponse, error)
 	DeleteById(context.Context, *IdResultRequest) (*emptypb.Empty, error)
 	Update(context.Context, *ResultRequest) (*emptypb.Empty, error)
-	Create(context.Context, *ResultCreateRequest) (*emptypb.Empty, error)
+	Create(context.Context, *ResultCreateRequest) (*ResultResponse, error)
+	FetchByGameId(context.Context, *IdGameRequest) (*ResultResponse, error)
+	ListResults(context.Context, *ListResultsRequest) (*ListResultsResponse, error)
+	WatchResults(*WatchResultsRequest, grpc.ServerStreamingServer[ResultEvent]) error
+	// The first message describes the file, the ones after it carry its
+	// content. Files are limited to 64 MiB.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error
+	AddAttachmentLink(context.Context, *AddAttachmentLinkRequest) (*Attachment, error)
+	ListAttachments(context.Context, *IdResultRequest) (*ListAttachmentsResponse, error)
+	// The first message describes the file, the ones after it carry its
+	// content. Demo links have no content and fail with FAILED_PRECONDITION.
+	DownloadAttachment(*IdAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	DeleteAttachment(context.Context, *IdAttachmentRequest) (*emptypb.Empty, error)
+	// Participants report results themselves. Once every participant of the
+	// game has reported, matching reports confirm the result and differing
+	// ones open a dispute a referee resolves with ResolveReview. Only
+	// confirmed and resolved reviews write a result.
+	ReportResult(context.Context, *ReportResultRequest) (*ResultReview, error)
+	FetchReview(context.Context, *IdGameRequest) (*ResultReview, error)
+	CommentReview(context.Context, *CommentReviewRequest) (*ReviewComment, error)
+	ResolveReview(context.Context, *ResolveReviewRequest) (*ResultReview, error)
 	mustEmbedUnimplementedResultsServiceServer()
 }
 
@@ -111,9 +304,45 @@ func (UnimplementedResultsServiceServer) DeleteById(context.Context, *IdResultRe
 func (UnimplementedResultsServiceServer) Update(context.Context, *ResultRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedResultsServiceServer) Create(context.Context, *ResultCreateRequest) (*emptypb.Empty, error) {
+func (UnimplementedResultsServiceServer) Create(context.Context, *ResultCreateRequest) (*ResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedResultsServiceServer) FetchByGameId(context.Context, *IdGameRequest) (*ResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchByGameId not implemented")
+}
+func (UnimplementedResultsServiceServer) ListResults(context.Context, *ListResultsRequest) (*ListResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResults not implemented")
+}
+func (UnimplementedResultsServiceServer) WatchResults(*WatchResultsRequest, grpc.ServerStreamingServer[ResultEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResults not implemented")
+}
+func (UnimplementedResultsServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedResultsServiceServer) AddAttachmentLink(context.Context, *AddAttachmentLinkRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttachmentLink not implemented")
+}
+func (UnimplementedResultsServiceServer) ListAttachments(context.Context, *IdResultRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedResultsServiceServer) DownloadAttachment(*IdAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedResultsServiceServer) DeleteAttachment(context.Context, *IdAttachmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedResultsServiceServer) ReportResult(context.Context, *ReportResultRequest) (*ResultReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportResult not implemented")
+}
+func (UnimplementedResultsServiceServer) FetchReview(context.Context, *IdGameRequest) (*ResultReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchReview not implemented")
+}
+func (UnimplementedResultsServiceServer) CommentReview(context.Context, *CommentReviewRequest) (*ReviewComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentReview not implemented")
+}
+func (UnimplementedResultsServiceServer) ResolveReview(context.Context, *ResolveReviewRequest) (*ResultReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReview not implemented")
+}
 func (UnimplementedResultsServiceServer) mustEmbedUnimplementedResultsServiceServer() {}
 func (UnimplementedResultsServiceServer) testEmbeddedByValue()                        {}
 
@@ -207,6 +436,197 @@ func _ResultsService_Create_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResultsService_FetchByGameId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).FetchByGameId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_FetchByGameId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).FetchByGameId(ctx, req.(*IdGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_ListResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).ListResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_ListResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).ListResults(ctx, req.(*ListResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_WatchResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResultsServiceServer).WatchResults(m, &grpc.GenericServerStream[WatchResultsRequest, ResultEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultsService_WatchResultsServer = grpc.ServerStreamingServer[ResultEvent]
+
+func _ResultsService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResultsServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, Attachment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultsService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]
+
+func _ResultsService_AddAttachmentLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttachmentLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).AddAttachmentLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_AddAttachmentLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).AddAttachmentLink(ctx, req.(*AddAttachmentLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).ListAttachments(ctx, req.(*IdResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IdAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResultsServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[IdAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultsService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _ResultsService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).DeleteAttachment(ctx, req.(*IdAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_ReportResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).ReportResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_ReportResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).ReportResult(ctx, req.(*ReportResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_FetchReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).FetchReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_FetchReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).FetchReview(ctx, req.(*IdGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_CommentReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).CommentReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_CommentReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).CommentReview(ctx, req.(*CommentReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultsService_ResolveReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultsServiceServer).ResolveReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultsService_ResolveReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultsServiceServer).ResolveReview(ctx, req.(*ResolveReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResultsService_ServiceDesc is the grpc.ServiceDesc for ResultsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,7 +650,59 @@ var ResultsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _ResultsService_Create_Handler,
 		},
+		{
+			MethodName: "FetchByGameId",
+			Handler:    _ResultsService_FetchByGameId_Handler,
+		},
+		{
+			MethodName: "ListResults",
+			Handler:    _ResultsService_ListResults_Handler,
+		},
+		{
+			MethodName: "AddAttachmentLink",
+			Handler:    _ResultsService_AddAttachmentLink_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _ResultsService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _ResultsService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "ReportResult",
+			Handler:    _ResultsService_ReportResult_Handler,
+		},
+		{
+			MethodName: "FetchReview",
+			Handler:    _ResultsService_FetchReview_Handler,
+		},
+		{
+			MethodName: "CommentReview",
+			Handler:    _ResultsService_CommentReview_Handler,
+		},
+		{
+			MethodName: "ResolveReview",
+			Handler:    _ResultsService_ResolveReview_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResults",
+			Handler:       _ResultsService_WatchResults_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _ResultsService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _ResultsService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/delivery/grpc/results_grpc/results.proto",
 }
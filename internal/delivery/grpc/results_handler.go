@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 	"tournaments-core/internal/delivery/grpc/results_grpc"
 	"tournaments-core/internal/domain/models"
@@ -17,13 +18,17 @@ import (
 
 type res_server struct {
 	results_grpc.UnimplementedResultsServiceServer
-	usecase usecase.ResultsUseCase
+	usecase     usecase.ResultsUseCase
+	attachments usecase.AttachmentsUseCase
+	events      usecase.EventsUseCase
 }
 
-func NewResultsGrpcServer(gserver *grpc.Server, rep *repository.ResultsRepository) {
+func NewResultsGrpcServer(gserver *grpc.Server, rep *repository.ResultsRepository, games_rep *repository.GamesRepository, tour_rep *repository.TournamentsRepository, grants_rep *repository.TournamentGrantsRepository, reviews_rep *repository.ResultReviewsRepository, part_rep *repository.ParticipantsRepository, transactor repository.Transactor, attachments_rep *repository.AttachmentsRepository, blobs repository.BlobStore, events usecase.EventsUseCase) {
 
 	resultsServer := &res_server{
-		usecase: usecase2.NewResultsUseCase(*rep, 10*time.Second),
+		usecase:     usecase2.NewResultsUseCase(*rep, *games_rep, *tour_rep, *grants_rep, *reviews_rep, *part_rep, *attachments_rep, blobs, transactor, 10*time.Second),
+		attachments: usecase2.NewAttachmentsUseCase(*attachments_rep, *rep, *games_rep, *reviews_rep, *grants_rep, blobs, 10*time.Second),
+		events:      events,
 	}
 
 	results_grpc.RegisterResultsServiceServer(gserver, resultsServer)
@@ -37,55 +42,197 @@ func (s res_server) FetchById(ctx context.Context, request *results_grpc.IdResul
 
 	r, err := s.usecase.FetchById(ctx, uuid)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, err.Error())
+		return nil, toStatus(err)
 	}
 
-	return &results_grpc.ResultResponse{
-		Id:       uuid.String(),
-		GameId:   r.GameID.String(),
-		WinnerId: r.WinnerID.String(),
-		Comment:  r.Comment,
+	return toResultResponse(r), nil
+}
+
+func (s res_server) FetchByGameId(ctx context.Context, request *results_grpc.IdGameRequest) (*results_grpc.ResultResponse, error) {
+	gameUuid, err := uuid2.Parse(request.GetGameId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := s.usecase.FetchByGameId(ctx, gameUuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toResultResponse(r), nil
+}
+
+func (s res_server) ListResults(ctx context.Context, request *results_grpc.ListResultsRequest) (*results_grpc.ListResultsResponse, error) {
+	gameUuid, err := parseNullUUID(request.GetGameId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	winnerUuid, err := parseNullUUID(request.GetWinnerId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter := models.ResultsFilter{
+		GameID:     gameUuid,
+		WinnerID:   winnerUuid,
+		Descending: request.GetDescending(),
+		Page: models.PageRequest{
+			Size:  int(request.GetPageSize()),
+			Token: request.GetPageToken(),
+		},
+	}
+	if request.CreatedFrom != nil {
+		filter.CreatedFrom = request.CreatedFrom.AsTime()
+	}
+	if request.CreatedTo != nil {
+		filter.CreatedTo = request.CreatedTo.AsTime()
+	}
+
+	page, err := s.usecase.List(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	results := make([]*results_grpc.ResultResponse, 0, len(page.Results))
+	for _, r := range page.Results {
+		results = append(results, toResultResponse(r))
+	}
+
+	return &results_grpc.ListResultsResponse{
+		Results:       results,
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
+func (s res_server) WatchResults(request *results_grpc.WatchResultsRequest, stream results_grpc.ResultsService_WatchResultsServer) error {
+	gameUuid, err := parseNullUUID(request.GetGameId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	tournamentUuid, err := parseNullUUID(request.GetTournamentId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter := models.EventFilter{
+		Entity:       models.EntityResult,
+		GameID:       gameUuid,
+		TournamentID: tournamentUuid,
+	}
+	err = s.events.Watch(stream.Context(), filter, request.GetFromSequence(), func(e models.Event) error {
+		return stream.Send(&results_grpc.ResultEvent{
+			Sequence: e.Sequence,
+			Type:     string(e.Type),
+			At:       timestamppb.New(e.At),
+			Result:   toResultResponse(*e.Result),
+		})
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+func toPlacements(placements []models.Placement) []*results_grpc.Placement {
+	response := make([]*results_grpc.Placement, 0, len(placements))
+	for _, p := range placements {
+		response = append(response, &results_grpc.Placement{
+			ParticipantId: p.ParticipantID.String(),
+			Place:         int32(p.Place),
+			Score:         p.Score,
+		})
+	}
+	return response
+}
+
+func toResultResponse(r models.Result) *results_grpc.ResultResponse {
+	placements := toPlacements(r.Placements)
+
+	var winnerIds []string
+	for _, w := range r.Winners() {
+		winnerIds = append(winnerIds, w.String())
+	}
+
+	var winnerId, forfeitedBy string
+	if r.WinnerID != uuid2.Nil {
+		winnerId = r.WinnerID.String()
+	}
+	if r.ForfeitedBy.Valid {
+		forfeitedBy = r.ForfeitedBy.UUID.String()
+	}
+
+	return &results_grpc.ResultResponse{
+		Id:          r.ResultID.String(),
+		GameId:      r.GameID.String(),
+		WinnerId:    winnerId,
+		Comment:     r.Comment,
+		Placements:  placements,
+		WinnerIds:   winnerIds,
+		Outcome:     toOutcome(r.Outcome),
+		ForfeitedBy: forfeitedBy,
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+		Version:     r.Version,
+	}
+}
+
 func (s res_server) DeleteById(ctx context.Context, request *results_grpc.IdResultRequest) (*emptypb.Empty, error) {
 	uuid, err := uuid2.Parse(request.GetId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.usecase.DeleteById(ctx, uuid)
+	err = s.usecase.DeleteById(ctx, uuid, request.GetVersion())
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, err.Error())
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (s res_server) Create(ctx context.Context, request *results_grpc.ResultCreateRequest) (*emptypb.Empty, error) {
+func (s res_server) Create(ctx context.Context, request *results_grpc.ResultCreateRequest) (*results_grpc.ResultResponse, error) {
+	resultId, err := parseNewID(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	gameId, err := uuid2.Parse(request.GameId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	winnerId, err := uuid2.Parse(request.WinnerId)
+	winnerId, placements, err := parseWinner(request.GetWinnerId(), request.GetPlacements())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	forfeitedBy, err := parseNullUUID(request.GetForfeitedBy())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
 	var result *models.Result
 	result = &models.Result{
-		ResultID: uuid2.New(),
-		GameID:   gameId,
-		WinnerID: winnerId,
-		Comment:  request.Comment,
+		ResultID:    resultId,
+		GameID:      gameId,
+		WinnerID:    winnerId,
+		Comment:     request.Comment,
+		Placements:  placements,
+		Outcome:     fromOutcome(request.GetOutcome()),
+		ForfeitedBy: forfeitedBy,
 	}
 
 	err = s.usecase.Create(ctx, result)
 	if err != nil {
-		return nil, status.Errorf(codes.Canceled, err.Error())
+		return nil, toStatus(err)
 	}
-	return &emptypb.Empty{}, nil
+
+	created, err := s.usecase.FetchById(ctx, resultId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toResultResponse(created), nil
 }
 
 func (s res_server) Update(ctx context.Context, request *results_grpc.ResultRequest) (*emptypb.Empty, error) {
@@ -94,28 +241,93 @@ func (s res_server) Update(ctx context.Context, request *results_grpc.ResultRequ
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	gameId, err := uuid2.Parse(request.GetGameId())
+	// Only a masked update may leave the game out.
+	mask := models.UpdateMask(request.GetUpdateMask().GetPaths())
+	var gameId uuid2.UUID
+	if len(mask) == 0 || request.GetGameId() != "" {
+		gameId, err = uuid2.Parse(request.GetGameId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	winnerId, placements, err := parseWinner(request.GetWinnerId(), request.GetPlacements())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	winnerId, err := uuid2.Parse(request.GetWinnerId())
+	forfeitedBy, err := parseNullUUID(request.GetForfeitedBy())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
 	var result *models.Result
 	result = &models.Result{
-		ResultID: uuid,
-		GameID:   gameId,
-		WinnerID: winnerId,
-		Comment:  request.Comment,
+		ResultID:    uuid,
+		GameID:      gameId,
+		WinnerID:    winnerId,
+		Comment:     request.Comment,
+		Placements:  placements,
+		Outcome:     fromOutcome(request.GetOutcome()),
+		ForfeitedBy: forfeitedBy,
+		Version:     request.GetVersion(),
 	}
 
-	err = s.usecase.Update(ctx, result)
+	err = s.usecase.Update(ctx, result, mask)
 	if err != nil {
-		return nil, status.Errorf(codes.Canceled, err.Error())
+		return nil, toStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// parseWinner reads the single winner and the placements of a request, an
+// empty winner is uuid.Nil.
+func parseWinner(winner string, request []*results_grpc.Placement) (uuid2.UUID, []models.Placement, error) {
+	var placements []models.Placement
+	for _, p := range request {
+		participantId, err := uuid2.Parse(p.GetParticipantId())
+		if err != nil {
+			return uuid2.Nil, nil, err
+		}
+		placements = append(placements, models.Placement{
+			ParticipantID: participantId,
+			Place:         int(p.GetPlace()),
+			Score:         p.GetScore(),
+		})
+	}
+
+	if winner == "" {
+		return uuid2.Nil, placements, nil
+	}
+
+	winnerId, err := uuid2.Parse(winner)
+	if err != nil {
+		return uuid2.Nil, nil, err
+	}
+
+	return winnerId, placements, nil
+}
+
+var outcomes = map[results_grpc.Outcome]models.ResultOutcome{
+	results_grpc.Outcome_OUTCOME_WIN:              models.OutcomeWin,
+	results_grpc.Outcome_OUTCOME_DRAW:             models.OutcomeDraw,
+	results_grpc.Outcome_OUTCOME_FORFEIT:          models.OutcomeForfeit,
+	results_grpc.Outcome_OUTCOME_DISQUALIFICATION: models.OutcomeDisqualification,
+	results_grpc.Outcome_OUTCOME_CANCELLED:        models.OutcomeCancelled,
+}
+
+// fromOutcome maps an unspecified outcome to an empty one so that the use
+// case can pick the default.
+func fromOutcome(o results_grpc.Outcome) models.ResultOutcome {
+	return outcomes[o]
+}
+
+func toOutcome(o models.ResultOutcome) results_grpc.Outcome {
+	for proto, outcome := range outcomes {
+		if outcome == o {
+			return proto
+		}
+	}
+	return results_grpc.Outcome_OUTCOME_UNSPECIFIED
+}
@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: internal/delivery/grpc/standings_grpc/standings.proto
+
+package standings_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StandingsRules are the points a win, draw and loss are worth and the
+// tiebreaks applied in order to participants level on points: head_to_head,
+// buchholz, score_diff and random. Participants still level after the last
+// tiebreak share their rank. Points left unset are the default ones, so that
+// zero points can be given explicitly.
+type StandingsRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Win           *float64               `protobuf:"fixed64,1,opt,name=win,proto3,oneof" json:"win,omitempty"`
+	Draw          *float64               `protobuf:"fixed64,2,opt,name=draw,proto3,oneof" json:"draw,omitempty"`
+	Loss          *float64               `protobuf:"fixed64,3,opt,name=loss,proto3,oneof" json:"loss,omitempty"`
+	Tiebreaks     []string               `protobuf:"bytes,4,rep,name=tiebreaks,proto3" json:"tiebreaks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandingsRules) Reset() {
+	*x = StandingsRules{}
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingsRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsRules) ProtoMessage() {}
+
+func (x *StandingsRules) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsRules.ProtoReflect.Descriptor instead.
+func (*StandingsRules) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StandingsRules) GetWin() float64 {
+	if x != nil && x.Win != nil {
+		return *x.Win
+	}
+	return 0
+}
+
+func (x *StandingsRules) GetDraw() float64 {
+	if x != nil && x.Draw != nil {
+		return *x.Draw
+	}
+	return 0
+}
+
+func (x *StandingsRules) GetLoss() float64 {
+	if x != nil && x.Loss != nil {
+		return *x.Loss
+	}
+	return 0
+}
+
+func (x *StandingsRules) GetTiebreaks() []string {
+	if x != nil {
+		return x.Tiebreaks
+	}
+	return nil
+}
+
+type StandingsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// Narrows the table to one group of a group stage, zero takes every game.
+	Group int32 `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
+	// Omitted rules score 3 points for a win, 1 for a draw and break ties by
+	// head_to_head, score_diff, buchholz.
+	Rules    *StandingsRules `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	PageSize int32           `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Only valid with the tournament, group and rules it was issued for.
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandingsRequest) Reset() {
+	*x = StandingsRequest{}
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsRequest) ProtoMessage() {}
+
+func (x *StandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsRequest.ProtoReflect.Descriptor instead.
+func (*StandingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StandingsRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *StandingsRequest) GetGroup() int32 {
+	if x != nil {
+		return x.Group
+	}
+	return 0
+}
+
+func (x *StandingsRequest) GetRules() *StandingsRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *StandingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StandingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type StandingRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Played        int32                  `protobuf:"varint,3,opt,name=played,proto3" json:"played,omitempty"`
+	Wins          int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	Draws         int32                  `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	Losses        int32                  `protobuf:"varint,6,opt,name=losses,proto3" json:"losses,omitempty"`
+	Points        float64                `protobuf:"fixed64,7,opt,name=points,proto3" json:"points,omitempty"`
+	ScoreFor      float64                `protobuf:"fixed64,8,opt,name=score_for,json=scoreFor,proto3" json:"score_for,omitempty"`
+	ScoreAgainst  float64                `protobuf:"fixed64,9,opt,name=score_against,json=scoreAgainst,proto3" json:"score_against,omitempty"`
+	ScoreDiff     float64                `protobuf:"fixed64,10,opt,name=score_diff,json=scoreDiff,proto3" json:"score_diff,omitempty"`
+	Buchholz      float64                `protobuf:"fixed64,11,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandingRow) Reset() {
+	*x = StandingRow{}
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingRow) ProtoMessage() {}
+
+func (x *StandingRow) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingRow.ProtoReflect.Descriptor instead.
+func (*StandingRow) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StandingRow) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *StandingRow) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *StandingRow) GetPlayed() int32 {
+	if x != nil {
+		return x.Played
+	}
+	return 0
+}
+
+func (x *StandingRow) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *StandingRow) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *StandingRow) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *StandingRow) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *StandingRow) GetScoreFor() float64 {
+	if x != nil {
+		return x.ScoreFor
+	}
+	return 0
+}
+
+func (x *StandingRow) GetScoreAgainst() float64 {
+	if x != nil {
+		return x.ScoreAgainst
+	}
+	return 0
+}
+
+func (x *StandingRow) GetScoreDiff() float64 {
+	if x != nil {
+		return x.ScoreDiff
+	}
+	return 0
+}
+
+func (x *StandingRow) GetBuchholz() float64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+type StandingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rows  []*StandingRow         `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	// The number of rows of the whole table.
+	Total         int32  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandingsResponse) Reset() {
+	*x = StandingsResponse{}
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsResponse) ProtoMessage() {}
+
+func (x *StandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsResponse.ProtoReflect.Descriptor instead.
+func (*StandingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StandingsResponse) GetRows() []*StandingRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *StandingsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *StandingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_internal_delivery_grpc_standings_grpc_standings_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_standings_grpc_standings_proto_rawDesc = "" +
+	"\n" +
+	"5internal/delivery/grpc/standings_grpc/standings.proto\x12\tstandings\"\x91\x01\n" +
+	"\x0eStandingsRules\x12\x15\n" +
+	"\x03win\x18\x01 \x01(\x01H\x00R\x03win\x88\x01\x01\x12\x17\n" +
+	"\x04draw\x18\x02 \x01(\x01H\x01R\x04draw\x88\x01\x01\x12\x17\n" +
+	"\x04loss\x18\x03 \x01(\x01H\x02R\x04loss\x88\x01\x01\x12\x1c\n" +
+	"\ttiebreaks\x18\x04 \x03(\tR\ttiebreaksB\x06\n" +
+	"\x04_winB\a\n" +
+	"\x05_drawB\a\n" +
+	"\x05_loss\"\xba\x01\n" +
+	"\x10StandingsRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\x05R\x05group\x12/\n" +
+	"\x05rules\x18\x03 \x01(\v2\x19.standings.StandingsRulesR\x05rules\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xb7\x02\n" +
+	"\vStandingRow\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06played\x18\x03 \x01(\x05R\x06played\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x14\n" +
+	"\x05draws\x18\x05 \x01(\x05R\x05draws\x12\x16\n" +
+	"\x06losses\x18\x06 \x01(\x05R\x06losses\x12\x16\n" +
+	"\x06points\x18\a \x01(\x01R\x06points\x12\x1b\n" +
+	"\tscore_for\x18\b \x01(\x01R\bscoreFor\x12#\n" +
+	"\rscore_against\x18\t \x01(\x01R\fscoreAgainst\x12\x1d\n" +
+	"\n" +
+	"score_diff\x18\n" +
+	" \x01(\x01R\tscoreDiff\x12\x1a\n" +
+	"\bbuchholz\x18\v \x01(\x01R\bbuchholz\"}\n" +
+	"\x11StandingsResponse\x12*\n" +
+	"\x04rows\x18\x01 \x03(\v2\x16.standings.StandingRowR\x04rows\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken2Z\n" +
+	"\x10StandingsService\x12F\n" +
+	"\tStandings\x12\x1b.standings.StandingsRequest\x1a\x1c.standings.StandingsResponseB'Z%internal/delivery/grpc/standings_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_standings_grpc_standings_proto_rawDesc), len(file_internal_delivery_grpc_standings_grpc_standings_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_standings_grpc_standings_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_internal_delivery_grpc_standings_grpc_standings_proto_goTypes = []any{
+	(*StandingsRules)(nil),    // 0: standings.StandingsRules
+	(*StandingsRequest)(nil),  // 1: standings.StandingsRequest
+	(*StandingRow)(nil),       // 2: standings.StandingRow
+	(*StandingsResponse)(nil), // 3: standings.StandingsResponse
+}
+var file_internal_delivery_grpc_standings_grpc_standings_proto_depIdxs = []int32{
+	0, // 0: standings.StandingsRequest.rules:type_name -> standings.StandingsRules
+	2, // 1: standings.StandingsResponse.rows:type_name -> standings.StandingRow
+	1, // 2: standings.StandingsService.Standings:input_type -> standings.StandingsRequest
+	3, // 3: standings.StandingsService.Standings:output_type -> standings.StandingsResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_standings_grpc_standings_proto_init() }
+func file_internal_delivery_grpc_standings_grpc_standings_proto_init() {
+	if File_internal_delivery_grpc_standings_grpc_standings_proto != nil {
+		return
+	}
+	file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_standings_grpc_standings_proto_rawDesc), len(file_internal_delivery_grpc_standings_grpc_standings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_delivery_grpc_standings_grpc_standings_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_grpc_standings_grpc_standings_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_grpc_standings_grpc_standings_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_grpc_standings_grpc_standings_proto = out.File
+	file_internal_delivery_grpc_standings_grpc_standings_proto_goTypes = nil
+	file_internal_delivery_grpc_standings_grpc_standings_proto_depIdxs = nil
+}
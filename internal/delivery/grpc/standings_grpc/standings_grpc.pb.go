@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0--rc1
+// source: internal/delivery/grpc/standings_grpc/standings.proto
+
+package standings_grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StandingsService_Standings_FullMethodName = "/standings.StandingsService/Standings"
+)
+
+// StandingsServiceClient is the client API for StandingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StandingsServiceClient interface {
+	// Standings computes the table of a tournament from the results of its
+	// games and returns it ranked, one page at a time.
+	Standings(ctx context.Context, in *StandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error)
+}
+
+type standingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStandingsServiceClient(cc grpc.ClientConnInterface) StandingsServiceClient {
+	return &standingsServiceClient{cc}
+}
+
+func (c *standingsServiceClient) Standings(ctx context.Context, in *StandingsRequest, opts ...grpc.CallOption) (*StandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandingsResponse)
+	err := c.cc.Invoke(ctx, StandingsService_Standings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StandingsServiceServer is the server API for StandingsService service.
+// All implementations must embed UnimplementedStandingsServiceServer
+// for forward compatibility.
+type StandingsServiceServer interface {
+	// Standings computes the table of a tournament from the results of its
+	// games and returns it ranked, one page at a time.
+	Standings(context.Context, *StandingsRequest) (*StandingsResponse, error)
+	mustEmbedUnimplementedStandingsServiceServer()
+}
+
+// UnimplementedStandingsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStandingsServiceServer struct{}
+
+func (UnimplementedStandingsServiceServer) Standings(context.Context, *StandingsRequest) (*StandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Standings not implemented")
+}
+func (UnimplementedStandingsServiceServer) mustEmbedUnimplementedStandingsServiceServer() {}
+func (UnimplementedStandingsServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeStandingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StandingsServiceServer will
+// result in compilation errors.
+type UnsafeStandingsServiceServer interface {
+	mustEmbedUnimplementedStandingsServiceServer()
+}
+
+func RegisterStandingsServiceServer(s grpc.ServiceRegistrar, srv StandingsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStandingsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StandingsService_ServiceDesc, srv)
+}
+
+func _StandingsService_Standings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingsServiceServer).Standings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandingsService_Standings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingsServiceServer).Standings(ctx, req.(*StandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StandingsService_ServiceDesc is the grpc.ServiceDesc for StandingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StandingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "standings.StandingsService",
+	HandlerType: (*StandingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Standings",
+			Handler:    _StandingsService_Standings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/delivery/grpc/standings_grpc/standings.proto",
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	uuid2 "github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+	"tournaments-core/internal/delivery/grpc/standings_grpc"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+	usecase2 "tournaments-core/internal/usecase"
+)
+
+type standings_server struct {
+	standings_grpc.UnimplementedStandingsServiceServer
+	usecase usecase.StandingsUseCase
+}
+
+func NewStandingsGrpcServer(gserver *grpc.Server, tour_rep *repository.TournamentsRepository, games_rep *repository.GamesRepository, res_rep *repository.ResultsRepository) {
+
+	standingsServer := &standings_server{
+		usecase: usecase2.NewStandingsUseCase(*tour_rep, *games_rep, *res_rep, 10*time.Second),
+	}
+
+	standings_grpc.RegisterStandingsServiceServer(gserver, standingsServer)
+}
+
+func (s standings_server) Standings(ctx context.Context, request *standings_grpc.StandingsRequest) (*standings_grpc.StandingsResponse, error) {
+	uuid, err := uuid2.Parse(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	query := models.StandingsQuery{
+		TournamentID: uuid,
+		Group:        int(request.GetGroup()),
+		Rules:        models.DefaultStandingsRules,
+		Page: models.PageRequest{
+			Size:  int(request.GetPageSize()),
+			Token: request.GetPageToken(),
+		},
+	}
+	if rules := request.GetRules(); rules != nil {
+		// Points that are not sent keep their default.
+		query.Rules = models.StandingsRules{
+			Win:  models.DefaultStandingsRules.Win,
+			Draw: models.DefaultStandingsRules.Draw,
+			Loss: models.DefaultStandingsRules.Loss,
+		}
+		if rules.Win != nil {
+			query.Rules.Win = rules.GetWin()
+		}
+		if rules.Draw != nil {
+			query.Rules.Draw = rules.GetDraw()
+		}
+		if rules.Loss != nil {
+			query.Rules.Loss = rules.GetLoss()
+		}
+		for _, t := range rules.GetTiebreaks() {
+			query.Rules.Tiebreaks = append(query.Rules.Tiebreaks, models.Tiebreak(t))
+		}
+	}
+
+	page, err := s.usecase.Standings(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &standings_grpc.StandingsResponse{
+		Total:         int32(page.Total),
+		NextPageToken: page.NextPageToken,
+	}
+	for _, r := range page.Rows {
+		response.Rows = append(response.Rows, &standings_grpc.StandingRow{
+			Rank:          int32(r.Rank),
+			ParticipantId: r.ParticipantID.String(),
+			Played:        int32(r.Played),
+			Wins:          int32(r.Wins),
+			Draws:         int32(r.Draws),
+			Losses:        int32(r.Losses),
+			Points:        r.Points,
+			ScoreFor:      r.ScoreFor,
+			ScoreAgainst:  r.ScoreAgainst,
+			ScoreDiff:     r.ScoreDiff,
+			Buchholz:      r.Buchholz,
+		})
+	}
+	return response, nil
+}
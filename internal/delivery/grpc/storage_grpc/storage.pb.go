@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: internal/delivery/grpc/storage_grpc/storage.proto
+
+package storage_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*PutRequest_Key
+	//	*PutRequest_Chunk
+	Data          isPutRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRequest) Reset() {
+	*x = PutRequest{}
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRequest) ProtoMessage() {}
+
+func (x *PutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRequest.ProtoReflect.Descriptor instead.
+func (*PutRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PutRequest) GetData() isPutRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PutRequest) GetKey() string {
+	if x != nil {
+		if x, ok := x.Data.(*PutRequest_Key); ok {
+			return x.Key
+		}
+	}
+	return ""
+}
+
+func (x *PutRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*PutRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isPutRequest_Data interface {
+	isPutRequest_Data()
+}
+
+type PutRequest_Key struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3,oneof"`
+}
+
+type PutRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*PutRequest_Key) isPutRequest_Data() {}
+
+func (*PutRequest_Chunk) isPutRequest_Data() {}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+var File_internal_delivery_grpc_storage_grpc_storage_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_storage_grpc_storage_proto_rawDesc = "" +
+	"\n" +
+	"1internal/delivery/grpc/storage_grpc/storage.proto\x12\astorage\x1a\x1bgoogle/protobuf/empty.proto\"@\n" +
+	"\n" +
+	"PutRequest\x12\x12\n" +
+	"\x03key\x18\x01 \x01(\tH\x00R\x03key\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\x1e\n" +
+	"\n" +
+	"GetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"#\n" +
+	"\vGetResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"!\n" +
+	"\rDeleteRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key2\xb4\x01\n" +
+	"\x0eStorageService\x124\n" +
+	"\x03Put\x12\x13.storage.PutRequest\x1a\x16.google.protobuf.Empty(\x01\x122\n" +
+	"\x03Get\x12\x13.storage.GetRequest\x1a\x14.storage.GetResponse0\x01\x128\n" +
+	"\x06Delete\x12\x16.storage.DeleteRequest\x1a\x16.google.protobuf.EmptyB%Z#internal/delivery/grpc/storage_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_storage_grpc_storage_proto_rawDesc), len(file_internal_delivery_grpc_storage_grpc_storage_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_storage_grpc_storage_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_internal_delivery_grpc_storage_grpc_storage_proto_goTypes = []any{
+	(*PutRequest)(nil),    // 0: storage.PutRequest
+	(*GetRequest)(nil),    // 1: storage.GetRequest
+	(*GetResponse)(nil),   // 2: storage.GetResponse
+	(*DeleteRequest)(nil), // 3: storage.DeleteRequest
+	(*emptypb.Empty)(nil), // 4: google.protobuf.Empty
+}
+var file_internal_delivery_grpc_storage_grpc_storage_proto_depIdxs = []int32{
+	0, // 0: storage.StorageService.Put:input_type -> storage.PutRequest
+	1, // 1: storage.StorageService.Get:input_type -> storage.GetRequest
+	3, // 2: storage.StorageService.Delete:input_type -> storage.DeleteRequest
+	4, // 3: storage.StorageService.Put:output_type -> google.protobuf.Empty
+	2, // 4: storage.StorageService.Get:output_type -> storage.GetResponse
+	4, // 5: storage.StorageService.Delete:output_type -> google.protobuf.Empty
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_storage_grpc_storage_proto_init() }
+func file_internal_delivery_grpc_storage_grpc_storage_proto_init() {
+	if File_internal_delivery_grpc_storage_grpc_storage_proto != nil {
+		return
+	}
+	file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes[0].OneofWrappers = []any{
+		(*PutRequest_Key)(nil),
+		(*PutRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_storage_grpc_storage_proto_rawDesc), len(file_internal_delivery_grpc_storage_grpc_storage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_delivery_grpc_storage_grpc_storage_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_grpc_storage_grpc_storage_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_grpc_storage_grpc_storage_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_grpc_storage_grpc_storage_proto = out.File
+	file_internal_delivery_grpc_storage_grpc_storage_proto_goTypes = nil
+	file_internal_delivery_grpc_storage_grpc_storage_proto_depIdxs = nil
+}
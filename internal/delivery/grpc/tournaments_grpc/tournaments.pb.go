@@ -0,0 +1,1534 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: internal/delivery/grpc/tournaments_grpc/tournaments.proto
+
+package tournaments_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IdTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdTournamentRequest) Reset() {
+	*x = IdTournamentRequest{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdTournamentRequest) ProtoMessage() {}
+
+func (x *IdTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdTournamentRequest.ProtoReflect.Descriptor instead.
+func (*IdTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IdTournamentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TournamentCreateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GameTypeId      string                 `protobuf:"bytes,2,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	Format          string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	GrandFinalReset bool                   `protobuf:"varint,6,opt,name=grand_final_reset,json=grandFinalReset,proto3" json:"grand_final_reset,omitempty"`
+	SlotLength      *durationpb.Duration   `protobuf:"bytes,7,opt,name=slot_length,json=slotLength,proto3" json:"slot_length,omitempty"`
+	Legs            int32                  `protobuf:"varint,8,opt,name=legs,proto3" json:"legs,omitempty"`
+	Groups          int32                  `protobuf:"varint,9,opt,name=groups,proto3" json:"groups,omitempty"`
+	GroupAdvance    int32                  `protobuf:"varint,10,opt,name=group_advance,json=groupAdvance,proto3" json:"group_advance,omitempty"`
+	SwissRounds     int32                  `protobuf:"varint,11,opt,name=swiss_rounds,json=swissRounds,proto3" json:"swiss_rounds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TournamentCreateRequest) Reset() {
+	*x = TournamentCreateRequest{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentCreateRequest) ProtoMessage() {}
+
+func (x *TournamentCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentCreateRequest.ProtoReflect.Descriptor instead.
+func (*TournamentCreateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TournamentCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentCreateRequest) GetGameTypeId() string {
+	if x != nil {
+		return x.GameTypeId
+	}
+	return ""
+}
+
+func (x *TournamentCreateRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *TournamentCreateRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *TournamentCreateRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *TournamentCreateRequest) GetGrandFinalReset() bool {
+	if x != nil {
+		return x.GrandFinalReset
+	}
+	return false
+}
+
+func (x *TournamentCreateRequest) GetSlotLength() *durationpb.Duration {
+	if x != nil {
+		return x.SlotLength
+	}
+	return nil
+}
+
+func (x *TournamentCreateRequest) GetLegs() int32 {
+	if x != nil {
+		return x.Legs
+	}
+	return 0
+}
+
+func (x *TournamentCreateRequest) GetGroups() int32 {
+	if x != nil {
+		return x.Groups
+	}
+	return 0
+}
+
+func (x *TournamentCreateRequest) GetGroupAdvance() int32 {
+	if x != nil {
+		return x.GroupAdvance
+	}
+	return 0
+}
+
+func (x *TournamentCreateRequest) GetSwissRounds() int32 {
+	if x != nil {
+		return x.SwissRounds
+	}
+	return 0
+}
+
+type TournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GameTypeId    string                 `protobuf:"bytes,3,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRequest) Reset() {
+	*x = TournamentRequest{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRequest) ProtoMessage() {}
+
+func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRequest.ProtoReflect.Descriptor instead.
+func (*TournamentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TournamentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentRequest) GetGameTypeId() string {
+	if x != nil {
+		return x.GameTypeId
+	}
+	return ""
+}
+
+func (x *TournamentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *TournamentRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *TournamentRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *TournamentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type TournamentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GameTypeId      string                 `protobuf:"bytes,3,opt,name=game_type_id,json=gameTypeId,proto3" json:"game_type_id,omitempty"`
+	Format          string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	GrandFinalReset bool                   `protobuf:"varint,8,opt,name=grand_final_reset,json=grandFinalReset,proto3" json:"grand_final_reset,omitempty"`
+	SlotLength      *durationpb.Duration   `protobuf:"bytes,9,opt,name=slot_length,json=slotLength,proto3" json:"slot_length,omitempty"`
+	Legs            int32                  `protobuf:"varint,10,opt,name=legs,proto3" json:"legs,omitempty"`
+	Groups          int32                  `protobuf:"varint,11,opt,name=groups,proto3" json:"groups,omitempty"`
+	GroupAdvance    int32                  `protobuf:"varint,12,opt,name=group_advance,json=groupAdvance,proto3" json:"group_advance,omitempty"`
+	SwissRounds     int32                  `protobuf:"varint,13,opt,name=swiss_rounds,json=swissRounds,proto3" json:"swiss_rounds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TournamentResponse) Reset() {
+	*x = TournamentResponse{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentResponse) ProtoMessage() {}
+
+func (x *TournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentResponse.ProtoReflect.Descriptor instead.
+func (*TournamentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TournamentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TournamentResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentResponse) GetGameTypeId() string {
+	if x != nil {
+		return x.GameTypeId
+	}
+	return ""
+}
+
+func (x *TournamentResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *TournamentResponse) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *TournamentResponse) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *TournamentResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TournamentResponse) GetGrandFinalReset() bool {
+	if x != nil {
+		return x.GrandFinalReset
+	}
+	return false
+}
+
+func (x *TournamentResponse) GetSlotLength() *durationpb.Duration {
+	if x != nil {
+		return x.SlotLength
+	}
+	return nil
+}
+
+func (x *TournamentResponse) GetLegs() int32 {
+	if x != nil {
+		return x.Legs
+	}
+	return 0
+}
+
+func (x *TournamentResponse) GetGroups() int32 {
+	if x != nil {
+		return x.Groups
+	}
+	return 0
+}
+
+func (x *TournamentResponse) GetGroupAdvance() int32 {
+	if x != nil {
+		return x.GroupAdvance
+	}
+	return 0
+}
+
+func (x *TournamentResponse) GetSwissRounds() int32 {
+	if x != nil {
+		return x.SwissRounds
+	}
+	return 0
+}
+
+// GenerateBracket lays out every game of the tournament according to its
+// format: a bracket for elimination formats, a schedule for round robin and
+// group stage.
+type GenerateBracketRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// Participants ordered by seed, strongest first.
+	ParticipantIds []string `protobuf:"bytes,2,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateBracketRequest) Reset() {
+	*x = GenerateBracketRequest{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateBracketRequest) ProtoMessage() {}
+
+func (x *GenerateBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateBracketRequest.ProtoReflect.Descriptor instead.
+func (*GenerateBracketRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenerateBracketRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GenerateBracketRequest) GetParticipantIds() []string {
+	if x != nil {
+		return x.ParticipantIds
+	}
+	return nil
+}
+
+type BracketGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Round         int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	GameStart     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
+	Participants  []*BracketSlot         `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	Bracket       string                 `protobuf:"bytes,6,opt,name=bracket,proto3" json:"bracket,omitempty"`
+	Group         int32                  `protobuf:"varint,7,opt,name=group,proto3" json:"group,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketGame) Reset() {
+	*x = BracketGame{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketGame) ProtoMessage() {}
+
+func (x *BracketGame) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketGame.ProtoReflect.Descriptor instead.
+func (*BracketGame) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BracketGame) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BracketGame) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BracketGame) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BracketGame) GetGameStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GameStart
+	}
+	return nil
+}
+
+func (x *BracketGame) GetParticipants() []*BracketSlot {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *BracketGame) GetBracket() string {
+	if x != nil {
+		return x.Bracket
+	}
+	return ""
+}
+
+func (x *BracketGame) GetGroup() int32 {
+	if x != nil {
+		return x.Group
+	}
+	return 0
+}
+
+func (x *BracketGame) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type BracketSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Slot          int32                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketSlot) Reset() {
+	*x = BracketSlot{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketSlot) ProtoMessage() {}
+
+func (x *BracketSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketSlot.ProtoReflect.Descriptor instead.
+func (*BracketSlot) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BracketSlot) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *BracketSlot) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type BracketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*BracketGame         `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketResponse) Reset() {
+	*x = BracketResponse{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketResponse) ProtoMessage() {}
+
+func (x *BracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketResponse.ProtoReflect.Descriptor instead.
+func (*BracketResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BracketResponse) GetGames() []*BracketGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type SwissStanding struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId   string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Score           float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Buchholz        float64                `protobuf:"fixed64,3,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+	SonnebornBerger float64                `protobuf:"fixed64,4,opt,name=sonneborn_berger,json=sonnebornBerger,proto3" json:"sonneborn_berger,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SwissStanding) Reset() {
+	*x = SwissStanding{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwissStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwissStanding) ProtoMessage() {}
+
+func (x *SwissStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwissStanding.ProtoReflect.Descriptor instead.
+func (*SwissStanding) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SwissStanding) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SwissStanding) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SwissStanding) GetBuchholz() float64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+func (x *SwissStanding) GetSonnebornBerger() float64 {
+	if x != nil {
+		return x.SonnebornBerger
+	}
+	return 0
+}
+
+type SwissStandingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*SwissStanding       `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwissStandingsResponse) Reset() {
+	*x = SwissStandingsResponse{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwissStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwissStandingsResponse) ProtoMessage() {}
+
+func (x *SwissStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwissStandingsResponse.ProtoReflect.Descriptor instead.
+func (*SwissStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SwissStandingsResponse) GetStandings() []*SwissStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+// Streams changes of a tournament, its games and their results as they
+// happen. Events after from_sequence are sent first, zero starts with the
+// next change. Sequences are outbox positions and survive restarts of the
+// service.
+type WatchTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	FromSequence  uint64                 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTournamentRequest) Reset() {
+	*x = WatchTournamentRequest{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTournamentRequest) ProtoMessage() {}
+
+func (x *WatchTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTournamentRequest.ProtoReflect.Descriptor instead.
+func (*WatchTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchTournamentRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *WatchTournamentRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+type TournamentResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId    string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WinnerIds []string               `protobuf:"bytes,3,rep,name=winner_ids,json=winnerIds,proto3" json:"winner_ids,omitempty"`
+	// win, draw, forfeit, disqualification or cancelled.
+	Outcome       string `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Comment       string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentResult) Reset() {
+	*x = TournamentResult{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentResult) ProtoMessage() {}
+
+func (x *TournamentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentResult.ProtoReflect.Descriptor instead.
+func (*TournamentResult) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TournamentResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TournamentResult) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *TournamentResult) GetWinnerIds() []string {
+	if x != nil {
+		return x.WinnerIds
+	}
+	return nil
+}
+
+func (x *TournamentResult) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *TournamentResult) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type TournamentEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// created, updated or deleted. Deleted entities carry their last state.
+	Type string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	At   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	// tournament, game or result.
+	Entity   string `protobuf:"bytes,4,opt,name=entity,proto3" json:"entity,omitempty"`
+	EntityId string `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*TournamentEvent_Tournament
+	//	*TournamentEvent_Game
+	//	*TournamentEvent_Result
+	Payload       isTournamentEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentEvent) Reset() {
+	*x = TournamentEvent{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentEvent) ProtoMessage() {}
+
+func (x *TournamentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentEvent.ProtoReflect.Descriptor instead.
+func (*TournamentEvent) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TournamentEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TournamentEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TournamentEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *TournamentEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *TournamentEvent) GetPayload() isTournamentEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetTournament() *TournamentResponse {
+	if x != nil {
+		if x, ok := x.Payload.(*TournamentEvent_Tournament); ok {
+			return x.Tournament
+		}
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetGame() *BracketGame {
+	if x != nil {
+		if x, ok := x.Payload.(*TournamentEvent_Game); ok {
+			return x.Game
+		}
+	}
+	return nil
+}
+
+func (x *TournamentEvent) GetResult() *TournamentResult {
+	if x != nil {
+		if x, ok := x.Payload.(*TournamentEvent_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+type isTournamentEvent_Payload interface {
+	isTournamentEvent_Payload()
+}
+
+type TournamentEvent_Tournament struct {
+	Tournament *TournamentResponse `protobuf:"bytes,6,opt,name=tournament,proto3,oneof"`
+}
+
+type TournamentEvent_Game struct {
+	Game *BracketGame `protobuf:"bytes,7,opt,name=game,proto3,oneof"`
+}
+
+type TournamentEvent_Result struct {
+	Result *TournamentResult `protobuf:"bytes,8,opt,name=result,proto3,oneof"`
+}
+
+func (*TournamentEvent_Tournament) isTournamentEvent_Payload() {}
+
+func (*TournamentEvent_Game) isTournamentEvent_Payload() {}
+
+func (*TournamentEvent_Result) isTournamentEvent_Payload() {}
+
+type TournamentGrant struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// The subject of the bearer tokens the grant applies to.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// owner, co_organiser or referee.
+	Access        string                 `protobuf:"bytes,3,opt,name=access,proto3" json:"access,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentGrant) Reset() {
+	*x = TournamentGrant{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentGrant) ProtoMessage() {}
+
+func (x *TournamentGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentGrant.ProtoReflect.Descriptor instead.
+func (*TournamentGrant) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TournamentGrant) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentGrant) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TournamentGrant) GetAccess() string {
+	if x != nil {
+		return x.Access
+	}
+	return ""
+}
+
+func (x *TournamentGrant) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *TournamentGrant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Gives a subject access to a tournament, replacing the access it had. Owners
+// grant any access, co-organisers only referee access.
+type GrantAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Access        string                 `protobuf:"bytes,3,opt,name=access,proto3" json:"access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantAccessRequest) Reset() {
+	*x = GrantAccessRequest{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAccessRequest) ProtoMessage() {}
+
+func (x *GrantAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantAccessRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GrantAccessRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *GrantAccessRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *GrantAccessRequest) GetAccess() string {
+	if x != nil {
+		return x.Access
+	}
+	return ""
+}
+
+type RevokeAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessRequest) Reset() {
+	*x = RevokeAccessRequest{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessRequest) ProtoMessage() {}
+
+func (x *RevokeAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccessRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeAccessRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *RevokeAccessRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type TournamentGrantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*TournamentGrant     `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentGrantsResponse) Reset() {
+	*x = TournamentGrantsResponse{}
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentGrantsResponse) ProtoMessage() {}
+
+func (x *TournamentGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentGrantsResponse.ProtoReflect.Descriptor instead.
+func (*TournamentGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TournamentGrantsResponse) GetGrants() []*TournamentGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+var File_internal_delivery_grpc_tournaments_grpc_tournaments_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDesc = "" +
+	"\n" +
+	"9internal/delivery/grpc/tournaments_grpc/tournaments.proto\x12\vtournaments\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\"%\n" +
+	"\x13IdTournamentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb1\x03\n" +
+	"\x17TournamentCreateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\fgame_type_id\x18\x02 \x01(\tR\n" +
+	"gameTypeId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x127\n" +
+	"\tstarts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12*\n" +
+	"\x11grand_final_reset\x18\x06 \x01(\bR\x0fgrandFinalReset\x12:\n" +
+	"\vslot_length\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"slotLength\x12\x12\n" +
+	"\x04legs\x18\b \x01(\x05R\x04legs\x12\x16\n" +
+	"\x06groups\x18\t \x01(\x05R\x06groups\x12#\n" +
+	"\rgroup_advance\x18\n" +
+	" \x01(\x05R\fgroupAdvance\x12!\n" +
+	"\fswiss_rounds\x18\v \x01(\x05R\vswissRounds\"\xf7\x01\n" +
+	"\x11TournamentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\fgame_type_id\x18\x03 \x01(\tR\n" +
+	"gameTypeId\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\xd4\x03\n" +
+	"\x12TournamentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\fgame_type_id\x18\x03 \x01(\tR\n" +
+	"gameTypeId\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12*\n" +
+	"\x11grand_final_reset\x18\b \x01(\bR\x0fgrandFinalReset\x12:\n" +
+	"\vslot_length\x18\t \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"slotLength\x12\x12\n" +
+	"\x04legs\x18\n" +
+	" \x01(\x05R\x04legs\x12\x16\n" +
+	"\x06groups\x18\v \x01(\x05R\x06groups\x12#\n" +
+	"\rgroup_advance\x18\f \x01(\x05R\fgroupAdvance\x12!\n" +
+	"\fswiss_rounds\x18\r \x01(\x05R\vswissRounds\"f\n" +
+	"\x16GenerateBracketRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12'\n" +
+	"\x0fparticipant_ids\x18\x02 \x03(\tR\x0eparticipantIds\"\x90\x02\n" +
+	"\vBracketGame\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"game_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tgameStart\x12<\n" +
+	"\fparticipants\x18\x05 \x03(\v2\x18.tournaments.BracketSlotR\fparticipants\x12\x18\n" +
+	"\abracket\x18\x06 \x01(\tR\abracket\x12\x14\n" +
+	"\x05group\x18\a \x01(\x05R\x05group\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"H\n" +
+	"\vBracketSlot\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x05R\x04slot\"A\n" +
+	"\x0fBracketResponse\x12.\n" +
+	"\x05games\x18\x01 \x03(\v2\x18.tournaments.BracketGameR\x05games\"\x93\x01\n" +
+	"\rSwissStanding\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1a\n" +
+	"\bbuchholz\x18\x03 \x01(\x01R\bbuchholz\x12)\n" +
+	"\x10sonneborn_berger\x18\x04 \x01(\x01R\x0fsonnebornBerger\"R\n" +
+	"\x16SwissStandingsResponse\x128\n" +
+	"\tstandings\x18\x01 \x03(\v2\x1a.tournaments.SwissStandingR\tstandings\"b\n" +
+	"\x16WatchTournamentRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12#\n" +
+	"\rfrom_sequence\x18\x02 \x01(\x04R\ffromSequence\"\x8e\x01\n" +
+	"\x10TournamentResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1d\n" +
+	"\n" +
+	"winner_ids\x18\x03 \x03(\tR\twinnerIds\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"\xd9\x02\n" +
+	"\x0fTournamentEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x16\n" +
+	"\x06entity\x18\x04 \x01(\tR\x06entity\x12\x1b\n" +
+	"\tentity_id\x18\x05 \x01(\tR\bentityId\x12A\n" +
+	"\n" +
+	"tournament\x18\x06 \x01(\v2\x1f.tournaments.TournamentResponseH\x00R\n" +
+	"tournament\x12.\n" +
+	"\x04game\x18\a \x01(\v2\x18.tournaments.BracketGameH\x00R\x04game\x127\n" +
+	"\x06result\x18\b \x01(\v2\x1d.tournaments.TournamentResultH\x00R\x06resultB\t\n" +
+	"\apayload\"\xc2\x01\n" +
+	"\x0fTournamentGrant\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x16\n" +
+	"\x06access\x18\x03 \x01(\tR\x06access\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x04 \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"k\n" +
+	"\x12GrantAccessRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x16\n" +
+	"\x06access\x18\x03 \x01(\tR\x06access\"T\n" +
+	"\x13RevokeAccessRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\"P\n" +
+	"\x18TournamentGrantsResponse\x124\n" +
+	"\x06grants\x18\x01 \x03(\v2\x1c.tournaments.TournamentGrantR\x06grants2\xac\x06\n" +
+	"\x12TournamentsService\x12N\n" +
+	"\tFetchById\x12 .tournaments.IdTournamentRequest\x1a\x1f.tournaments.TournamentResponse\x12F\n" +
+	"\n" +
+	"DeleteById\x12 .tournaments.IdTournamentRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\x06Update\x12\x1e.tournaments.TournamentRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x06Create\x12$.tournaments.TournamentCreateRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x0fGenerateBracket\x12#.tournaments.GenerateBracketRequest\x1a\x1c.tournaments.BracketResponse\x12W\n" +
+	"\x0eSwissStandings\x12 .tournaments.IdTournamentRequest\x1a#.tournaments.SwissStandingsResponse\x12V\n" +
+	"\x0fWatchTournament\x12#.tournaments.WatchTournamentRequest\x1a\x1c.tournaments.TournamentEvent0\x01\x12L\n" +
+	"\vGrantAccess\x12\x1f.tournaments.GrantAccessRequest\x1a\x1c.tournaments.TournamentGrant\x12H\n" +
+	"\fRevokeAccess\x12 .tournaments.RevokeAccessRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\n" +
+	"ListGrants\x12 .tournaments.IdTournamentRequest\x1a%.tournaments.TournamentGrantsResponseB)Z'internal/delivery/grpc/tournaments_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDesc), len(file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_goTypes = []any{
+	(*IdTournamentRequest)(nil),      // 0: tournaments.IdTournamentRequest
+	(*TournamentCreateRequest)(nil),  // 1: tournaments.TournamentCreateRequest
+	(*TournamentRequest)(nil),        // 2: tournaments.TournamentRequest
+	(*TournamentResponse)(nil),       // 3: tournaments.TournamentResponse
+	(*GenerateBracketRequest)(nil),   // 4: tournaments.GenerateBracketRequest
+	(*BracketGame)(nil),              // 5: tournaments.BracketGame
+	(*BracketSlot)(nil),              // 6: tournaments.BracketSlot
+	(*BracketResponse)(nil),          // 7: tournaments.BracketResponse
+	(*SwissStanding)(nil),            // 8: tournaments.SwissStanding
+	(*SwissStandingsResponse)(nil),   // 9: tournaments.SwissStandingsResponse
+	(*WatchTournamentRequest)(nil),   // 10: tournaments.WatchTournamentRequest
+	(*TournamentResult)(nil),         // 11: tournaments.TournamentResult
+	(*TournamentEvent)(nil),          // 12: tournaments.TournamentEvent
+	(*TournamentGrant)(nil),          // 13: tournaments.TournamentGrant
+	(*GrantAccessRequest)(nil),       // 14: tournaments.GrantAccessRequest
+	(*RevokeAccessRequest)(nil),      // 15: tournaments.RevokeAccessRequest
+	(*TournamentGrantsResponse)(nil), // 16: tournaments.TournamentGrantsResponse
+	(*timestamppb.Timestamp)(nil),    // 17: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),      // 18: google.protobuf.Duration
+	(*emptypb.Empty)(nil),            // 19: google.protobuf.Empty
+}
+var file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_depIdxs = []int32{
+	17, // 0: tournaments.TournamentCreateRequest.starts_at:type_name -> google.protobuf.Timestamp
+	17, // 1: tournaments.TournamentCreateRequest.ends_at:type_name -> google.protobuf.Timestamp
+	18, // 2: tournaments.TournamentCreateRequest.slot_length:type_name -> google.protobuf.Duration
+	17, // 3: tournaments.TournamentRequest.starts_at:type_name -> google.protobuf.Timestamp
+	17, // 4: tournaments.TournamentRequest.ends_at:type_name -> google.protobuf.Timestamp
+	17, // 5: tournaments.TournamentResponse.starts_at:type_name -> google.protobuf.Timestamp
+	17, // 6: tournaments.TournamentResponse.ends_at:type_name -> google.protobuf.Timestamp
+	18, // 7: tournaments.TournamentResponse.slot_length:type_name -> google.protobuf.Duration
+	17, // 8: tournaments.BracketGame.game_start:type_name -> google.protobuf.Timestamp
+	6,  // 9: tournaments.BracketGame.participants:type_name -> tournaments.BracketSlot
+	5,  // 10: tournaments.BracketResponse.games:type_name -> tournaments.BracketGame
+	8,  // 11: tournaments.SwissStandingsResponse.standings:type_name -> tournaments.SwissStanding
+	17, // 12: tournaments.TournamentEvent.at:type_name -> google.protobuf.Timestamp
+	3,  // 13: tournaments.TournamentEvent.tournament:type_name -> tournaments.TournamentResponse
+	5,  // 14: tournaments.TournamentEvent.game:type_name -> tournaments.BracketGame
+	11, // 15: tournaments.TournamentEvent.result:type_name -> tournaments.TournamentResult
+	17, // 16: tournaments.TournamentGrant.created_at:type_name -> google.protobuf.Timestamp
+	13, // 17: tournaments.TournamentGrantsResponse.grants:type_name -> tournaments.TournamentGrant
+	0,  // 18: tournaments.TournamentsService.FetchById:input_type -> tournaments.IdTournamentRequest
+	0,  // 19: tournaments.TournamentsService.DeleteById:input_type -> tournaments.IdTournamentRequest
+	2,  // 20: tournaments.TournamentsService.Update:input_type -> tournaments.TournamentRequest
+	1,  // 21: tournaments.TournamentsService.Create:input_type -> tournaments.TournamentCreateRequest
+	4,  // 22: tournaments.TournamentsService.GenerateBracket:input_type -> tournaments.GenerateBracketRequest
+	0,  // 23: tournaments.TournamentsService.SwissStandings:input_type -> tournaments.IdTournamentRequest
+	10, // 24: tournaments.TournamentsService.WatchTournament:input_type -> tournaments.WatchTournamentRequest
+	14, // 25: tournaments.TournamentsService.GrantAccess:input_type -> tournaments.GrantAccessRequest
+	15, // 26: tournaments.TournamentsService.RevokeAccess:input_type -> tournaments.RevokeAccessRequest
+	0,  // 27: tournaments.TournamentsService.ListGrants:input_type -> tournaments.IdTournamentRequest
+	3,  // 28: tournaments.TournamentsService.FetchById:output_type -> tournaments.TournamentResponse
+	19, // 29: tournaments.TournamentsService.DeleteById:output_type -> google.protobuf.Empty
+	19, // 30: tournaments.TournamentsService.Update:output_type -> google.protobuf.Empty
+	19, // 31: tournaments.TournamentsService.Create:output_type -> google.protobuf.Empty
+	7,  // 32: tournaments.TournamentsService.GenerateBracket:output_type -> tournaments.BracketResponse
+	9,  // 33: tournaments.TournamentsService.SwissStandings:output_type -> tournaments.SwissStandingsResponse
+	12, // 34: tournaments.TournamentsService.WatchTournament:output_type -> tournaments.TournamentEvent
+	13, // 35: tournaments.TournamentsService.GrantAccess:output_type -> tournaments.TournamentGrant
+	19, // 36: tournaments.TournamentsService.RevokeAccess:output_type -> google.protobuf.Empty
+	16, // 37: tournaments.TournamentsService.ListGrants:output_type -> tournaments.TournamentGrantsResponse
+	28, // [28:38] is the sub-list for method output_type
+	18, // [18:28] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_init() }
+func file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_init() {
+	if File_internal_delivery_grpc_tournaments_grpc_tournaments_proto != nil {
+		return
+	}
+	file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes[12].OneofWrappers = []any{
+		(*TournamentEvent_Tournament)(nil),
+		(*TournamentEvent_Game)(nil),
+		(*TournamentEvent_Result)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDesc), len(file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_grpc_tournaments_grpc_tournaments_proto = out.File
+	file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_goTypes = nil
+	file_internal_delivery_grpc_tournaments_grpc_tournaments_proto_depIdxs = nil
+}
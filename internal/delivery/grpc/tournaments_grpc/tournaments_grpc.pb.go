@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0--rc1
+// source: internal/delivery/grpc/tournaments_grpc/tournaments.proto
+
+package tournaments_grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TournamentsService_FetchById_FullMethodName       = "/tournaments.TournamentsService/FetchById"
+	TournamentsService_DeleteById_FullMethodName      = "/tournaments.TournamentsService/DeleteById"
+	TournamentsService_Update_FullMethodName          = "/tournaments.TournamentsService/Update"
+	TournamentsService_Create_FullMethodName          = "/tournaments.TournamentsService/Create"
+	TournamentsService_GenerateBracket_FullMethodName = "/tournaments.TournamentsService/GenerateBracket"
+	TournamentsService_SwissStandings_FullMethodName  = "/tournaments.TournamentsService/SwissStandings"
+	TournamentsService_WatchTournament_FullMethodName = "/tournaments.TournamentsService/WatchTournament"
+	TournamentsService_GrantAccess_FullMethodName     = "/tournaments.TournamentsService/GrantAccess"
+	TournamentsService_RevokeAccess_FullMethodName    = "/tournaments.TournamentsService/RevokeAccess"
+	TournamentsService_ListGrants_FullMethodName      = "/tournaments.TournamentsService/ListGrants"
+)
+
+// TournamentsServiceClient is the client API for TournamentsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TournamentsServiceClient interface {
+	FetchById(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error)
+	DeleteById(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Update(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Create(ctx context.Context, in *TournamentCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GenerateBracket(ctx context.Context, in *GenerateBracketRequest, opts ...grpc.CallOption) (*BracketResponse, error)
+	SwissStandings(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*SwissStandingsResponse, error)
+	WatchTournament(ctx context.Context, in *WatchTournamentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TournamentEvent], error)
+	GrantAccess(ctx context.Context, in *GrantAccessRequest, opts ...grpc.CallOption) (*TournamentGrant, error)
+	RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListGrants(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*TournamentGrantsResponse, error)
+}
+
+type tournamentsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTournamentsServiceClient(cc grpc.ClientConnInterface) TournamentsServiceClient {
+	return &tournamentsServiceClient{cc}
+}
+
+func (c *tournamentsServiceClient) FetchById(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*TournamentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TournamentResponse)
+	err := c.cc.Invoke(ctx, TournamentsService_FetchById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) DeleteById(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TournamentsService_DeleteById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) Update(ctx context.Context, in *TournamentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TournamentsService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) Create(ctx context.Context, in *TournamentCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TournamentsService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) GenerateBracket(ctx context.Context, in *GenerateBracketRequest, opts ...grpc.CallOption) (*BracketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BracketResponse)
+	err := c.cc.Invoke(ctx, TournamentsService_GenerateBracket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) SwissStandings(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*SwissStandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwissStandingsResponse)
+	err := c.cc.Invoke(ctx, TournamentsService_SwissStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) WatchTournament(ctx context.Context, in *WatchTournamentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TournamentEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TournamentsService_ServiceDesc.Streams[0], TournamentsService_WatchTournament_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTournamentRequest, TournamentEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TournamentsService_WatchTournamentClient = grpc.ServerStreamingClient[TournamentEvent]
+
+func (c *tournamentsServiceClient) GrantAccess(ctx context.Context, in *GrantAccessRequest, opts ...grpc.CallOption) (*TournamentGrant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TournamentGrant)
+	err := c.cc.Invoke(ctx, TournamentsService_GrantAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TournamentsService_RevokeAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tournamentsServiceClient) ListGrants(ctx context.Context, in *IdTournamentRequest, opts ...grpc.CallOption) (*TournamentGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TournamentGrantsResponse)
+	err := c.cc.Invoke(ctx, TournamentsService_ListGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TournamentsServiceServer is the server API for TournamentsService service.
+// All implementations must embed UnimplementedTournamentsServiceServer
+// for forward compatibility.
+type TournamentsServiceServer interface {
+	FetchById(context.Context, *IdTournamentRequest) (*TournamentResponse, error)
+	DeleteById(context.Context, *IdTournamentRequest) (*emptypb.Empty, error)
+	Update(context.Context, *TournamentRequest) (*emptypb.Empty, error)
+	Create(context.Context, *TournamentCreateRequest) (*emptypb.Empty, error)
+	GenerateBracket(context.Context, *GenerateBracketRequest) (*BracketResponse, error)
+	SwissStandings(context.Context, *IdTournamentRequest) (*SwissStandingsResponse, error)
+	WatchTournament(*WatchTournamentRequest, grpc.ServerStreamingServer[TournamentEvent]) error
+	GrantAccess(context.Context, *GrantAccessRequest) (*TournamentGrant, error)
+	RevokeAccess(context.Context, *RevokeAccessRequest) (*emptypb.Empty, error)
+	ListGrants(context.Context, *IdTournamentRequest) (*TournamentGrantsResponse, error)
+	mustEmbedUnimplementedTournamentsServiceServer()
+}
+
+// UnimplementedTournamentsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTournamentsServiceServer struct{}
+
+func (UnimplementedTournamentsServiceServer) FetchById(context.Context, *IdTournamentRequest) (*TournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchById not implemented")
+}
+func (UnimplementedTournamentsServiceServer) DeleteById(context.Context, *IdTournamentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteById not implemented")
+}
+func (UnimplementedTournamentsServiceServer) Update(context.Context, *TournamentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTournamentsServiceServer) Create(context.Context, *TournamentCreateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTournamentsServiceServer) GenerateBracket(context.Context, *GenerateBracketRequest) (*BracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateBracket not implemented")
+}
+func (UnimplementedTournamentsServiceServer) SwissStandings(context.Context, *IdTournamentRequest) (*SwissStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwissStandings not implemented")
+}
+func (UnimplementedTournamentsServiceServer) WatchTournament(*WatchTournamentRequest, grpc.ServerStreamingServer[TournamentEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTournament not implemented")
+}
+func (UnimplementedTournamentsServiceServer) GrantAccess(context.Context, *GrantAccessRequest) (*TournamentGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAccess not implemented")
+}
+func (UnimplementedTournamentsServiceServer) RevokeAccess(context.Context, *RevokeAccessRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccess not implemented")
+}
+func (UnimplementedTournamentsServiceServer) ListGrants(context.Context, *IdTournamentRequest) (*TournamentGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
+func (UnimplementedTournamentsServiceServer) mustEmbedUnimplementedTournamentsServiceServer() {}
+func (UnimplementedTournamentsServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTournamentsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TournamentsServiceServer will
+// result in compilation errors.
+type UnsafeTournamentsServiceServer interface {
+	mustEmbedUnimplementedTournamentsServiceServer()
+}
+
+func RegisterTournamentsServiceServer(s grpc.ServiceRegistrar, srv TournamentsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTournamentsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TournamentsService_ServiceDesc, srv)
+}
+
+func _TournamentsService_FetchById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).FetchById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_FetchById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).FetchById(ctx, req.(*IdTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_DeleteById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).DeleteById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_DeleteById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).DeleteById(ctx, req.(*IdTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).Update(ctx, req.(*TournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).Create(ctx, req.(*TournamentCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_GenerateBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).GenerateBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_GenerateBracket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).GenerateBracket(ctx, req.(*GenerateBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_SwissStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).SwissStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_SwissStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).SwissStandings(ctx, req.(*IdTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_WatchTournament_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTournamentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TournamentsServiceServer).WatchTournament(m, &grpc.GenericServerStream[WatchTournamentRequest, TournamentEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TournamentsService_WatchTournamentServer = grpc.ServerStreamingServer[TournamentEvent]
+
+func _TournamentsService_GrantAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).GrantAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_GrantAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).GrantAccess(ctx, req.(*GrantAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_RevokeAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).RevokeAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_RevokeAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).RevokeAccess(ctx, req.(*RevokeAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TournamentsService_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TournamentsServiceServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TournamentsService_ListGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TournamentsServiceServer).ListGrants(ctx, req.(*IdTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TournamentsService_ServiceDesc is the grpc.ServiceDesc for TournamentsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TournamentsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tournaments.TournamentsService",
+	HandlerType: (*TournamentsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchById",
+			Handler:    _TournamentsService_FetchById_Handler,
+		},
+		{
+			MethodName: "DeleteById",
+			Handler:    _TournamentsService_DeleteById_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TournamentsService_Update_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _TournamentsService_Create_Handler,
+		},
+		{
+			MethodName: "GenerateBracket",
+			Handler:    _TournamentsService_GenerateBracket_Handler,
+		},
+		{
+			MethodName: "SwissStandings",
+			Handler:    _TournamentsService_SwissStandings_Handler,
+		},
+		{
+			MethodName: "GrantAccess",
+			Handler:    _TournamentsService_GrantAccess_Handler,
+		},
+		{
+			MethodName: "RevokeAccess",
+			Handler:    _TournamentsService_RevokeAccess_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _TournamentsService_ListGrants_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTournament",
+			Handler:       _TournamentsService_WatchTournament_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/delivery/grpc/tournaments_grpc/tournaments.proto",
+}
@@ -0,0 +1,330 @@
+package grpc
+
+import (
+	"context"
+	uuid2 "github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+	"tournaments-core/internal/delivery/grpc/tournaments_grpc"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+	usecase2 "tournaments-core/internal/usecase"
+)
+
+type tournaments_server struct {
+	tournaments_grpc.UnimplementedTournamentsServiceServer
+	usecase usecase.TournamentsUseCase
+	events  usecase.EventsUseCase
+}
+
+func NewTournamentsGrpcServer(gserver *grpc.Server, rep *repository.TournamentsRepository, games_rep *repository.GamesRepository, res_rep *repository.ResultsRepository, grants_rep *repository.TournamentGrantsRepository, transactor repository.Transactor, events usecase.EventsUseCase) {
+
+	tournamentsServer := &tournaments_server{
+		usecase: usecase2.NewTournamentsUseCase(*rep, *games_rep, *res_rep, *grants_rep, transactor, 10*time.Second),
+		events:  events,
+	}
+
+	tournaments_grpc.RegisterTournamentsServiceServer(gserver, tournamentsServer)
+}
+
+func (s tournaments_server) FetchById(ctx context.Context, request *tournaments_grpc.IdTournamentRequest) (*tournaments_grpc.TournamentResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	t, err := s.usecase.FetchById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toTournamentResponse(t), nil
+}
+
+func toTournamentResponse(t models.Tournament) *tournaments_grpc.TournamentResponse {
+	return &tournaments_grpc.TournamentResponse{
+		Id:              t.TournamentID.String(),
+		Name:            t.Name,
+		GameTypeId:      t.GameTypeID.String(),
+		Format:          string(t.Format),
+		StartsAt:        timestamppb.New(t.StartsAt),
+		EndsAt:          timestamppb.New(t.EndsAt),
+		Status:          string(t.Status),
+		GrandFinalReset: t.GrandFinalReset,
+		SlotLength:      durationpb.New(t.SlotLength),
+		Legs:            int32(t.Legs),
+		Groups:          int32(t.Groups),
+		GroupAdvance:    int32(t.GroupAdvance),
+		SwissRounds:     int32(t.SwissRounds),
+	}
+}
+
+func (s tournaments_server) DeleteById(ctx context.Context, request *tournaments_grpc.IdTournamentRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.usecase.DeleteById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s tournaments_server) Update(ctx context.Context, request *tournaments_grpc.TournamentRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	var gameTypeUuid uuid2.UUID
+	if request.GetGameTypeId() != "" {
+		gameTypeUuid, err = uuid2.Parse(request.GetGameTypeId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var tournament *models.Tournament
+	tournament = &models.Tournament{
+		TournamentID: uuid,
+		Name:         request.GetName(),
+		GameTypeID:   gameTypeUuid,
+		Format:       models.TournamentFormat(request.GetFormat()),
+		Status:       models.TournamentStatus(request.GetStatus()),
+	}
+	if request.StartsAt != nil {
+		tournament.StartsAt = request.StartsAt.AsTime()
+	}
+	if request.EndsAt != nil {
+		tournament.EndsAt = request.EndsAt.AsTime()
+	}
+
+	err = s.usecase.Update(ctx, tournament)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s tournaments_server) Create(ctx context.Context, request *tournaments_grpc.TournamentCreateRequest) (*emptypb.Empty, error) {
+	var tournament *models.Tournament
+
+	gameTypeUuid, err := uuid2.Parse(request.GetGameTypeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tournament = &models.Tournament{
+		TournamentID:    uuid2.New(),
+		Name:            request.GetName(),
+		GameTypeID:      gameTypeUuid,
+		Format:          models.TournamentFormat(request.GetFormat()),
+		GrandFinalReset: request.GetGrandFinalReset(),
+		SlotLength:      request.GetSlotLength().AsDuration(),
+		Legs:            int(request.GetLegs()),
+		Groups:          int(request.GetGroups()),
+		GroupAdvance:    int(request.GetGroupAdvance()),
+		SwissRounds:     int(request.GetSwissRounds()),
+	}
+	if request.StartsAt != nil {
+		tournament.StartsAt = request.StartsAt.AsTime()
+	}
+	if request.EndsAt != nil {
+		tournament.EndsAt = request.EndsAt.AsTime()
+	}
+	err = s.usecase.Create(ctx, tournament)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s tournaments_server) GenerateBracket(ctx context.Context, request *tournaments_grpc.GenerateBracketRequest) (*tournaments_grpc.BracketResponse, error) {
+	uuid, err := uuid2.Parse(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	seeds := make([]uuid2.UUID, 0, len(request.GetParticipantIds()))
+	for _, id := range request.GetParticipantIds() {
+		seed, err := uuid2.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		seeds = append(seeds, seed)
+	}
+
+	games, err := s.usecase.GenerateBracket(ctx, uuid, seeds)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &tournaments_grpc.BracketResponse{Games: toBracketGames(games)}, nil
+}
+
+func (s tournaments_server) SwissStandings(ctx context.Context, request *tournaments_grpc.IdTournamentRequest) (*tournaments_grpc.SwissStandingsResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	standings, err := s.usecase.SwissStandings(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &tournaments_grpc.SwissStandingsResponse{}
+	for _, st := range standings {
+		response.Standings = append(response.Standings, &tournaments_grpc.SwissStanding{
+			ParticipantId:   st.ParticipantID.String(),
+			Score:           st.Score,
+			Buchholz:        st.Buchholz,
+			SonnebornBerger: st.SonnebornBerger,
+		})
+	}
+
+	return response, nil
+}
+
+func toBracketGames(games []models.Game) []*tournaments_grpc.BracketGame {
+	bracket := make([]*tournaments_grpc.BracketGame, 0, len(games))
+	for _, g := range games {
+		bracket = append(bracket, toBracketGame(g))
+	}
+	return bracket
+}
+
+func toBracketGame(g models.Game) *tournaments_grpc.BracketGame {
+	participants := make([]*tournaments_grpc.BracketSlot, 0, len(g.Participants))
+	for _, p := range g.Participants {
+		participants = append(participants, &tournaments_grpc.BracketSlot{
+			ParticipantId: p.ParticipantID.String(),
+			Slot:          int32(p.Slot),
+		})
+	}
+	return &tournaments_grpc.BracketGame{
+		Id:           g.GameID.String(),
+		Round:        int32(g.Round),
+		Position:     int32(g.Position),
+		GameStart:    timestamppb.New(g.GameStart),
+		Participants: participants,
+		Bracket:      string(g.Bracket),
+		Group:        int32(g.Group),
+		Status:       string(g.Status),
+	}
+}
+
+func (s tournaments_server) WatchTournament(request *tournaments_grpc.WatchTournamentRequest, stream tournaments_grpc.TournamentsService_WatchTournamentServer) error {
+	tournamentUuid, err := uuid2.Parse(request.GetTournamentId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter := models.EventFilter{TournamentID: uuid2.NullUUID{UUID: tournamentUuid, Valid: true}}
+	err = s.events.Watch(stream.Context(), filter, request.GetFromSequence(), func(e models.Event) error {
+		event := &tournaments_grpc.TournamentEvent{
+			Sequence: e.Sequence,
+			Type:     string(e.Type),
+			At:       timestamppb.New(e.At),
+			Entity:   string(e.Entity),
+			EntityId: e.EntityID.String(),
+		}
+		switch {
+		case e.Tournament != nil:
+			event.Payload = &tournaments_grpc.TournamentEvent_Tournament{Tournament: toTournamentResponse(*e.Tournament)}
+		case e.Game != nil:
+			event.Payload = &tournaments_grpc.TournamentEvent_Game{Game: toBracketGame(*e.Game)}
+		case e.Result != nil:
+			event.Payload = &tournaments_grpc.TournamentEvent_Result{Result: toTournamentResult(*e.Result)}
+		}
+		return stream.Send(event)
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+func toTournamentResult(r models.Result) *tournaments_grpc.TournamentResult {
+	winnerIds := make([]string, 0, 1)
+	for _, w := range r.Winners() {
+		winnerIds = append(winnerIds, w.String())
+	}
+	return &tournaments_grpc.TournamentResult{
+		Id:        r.ResultID.String(),
+		GameId:    r.GameID.String(),
+		WinnerIds: winnerIds,
+		Outcome:   string(r.Outcome),
+		Comment:   r.Comment,
+	}
+}
+
+func (s tournaments_server) GrantAccess(ctx context.Context, request *tournaments_grpc.GrantAccessRequest) (*tournaments_grpc.TournamentGrant, error) {
+	uuid, err := uuid2.Parse(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	grant := models.TournamentGrant{
+		TournamentID: uuid,
+		Subject:      request.GetSubject(),
+		Access:       models.GrantAccess(request.GetAccess()),
+	}
+	if err := s.usecase.Grant(ctx, &grant); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toTournamentGrant(grant), nil
+}
+
+func (s tournaments_server) RevokeAccess(ctx context.Context, request *tournaments_grpc.RevokeAccessRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.usecase.Revoke(ctx, uuid, request.GetSubject()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s tournaments_server) ListGrants(ctx context.Context, request *tournaments_grpc.IdTournamentRequest) (*tournaments_grpc.TournamentGrantsResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	grants, err := s.usecase.Grants(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &tournaments_grpc.TournamentGrantsResponse{}
+	for _, g := range grants {
+		response.Grants = append(response.Grants, toTournamentGrant(g))
+	}
+
+	return response, nil
+}
+
+func toTournamentGrant(g models.TournamentGrant) *tournaments_grpc.TournamentGrant {
+	return &tournaments_grpc.TournamentGrant{
+		TournamentId: g.TournamentID.String(),
+		Subject:      g.Subject,
+		Access:       string(g.Access),
+		GrantedBy:    g.GrantedBy,
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+	}
+}
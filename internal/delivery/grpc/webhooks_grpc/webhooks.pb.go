@@ -0,0 +1,864 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: internal/delivery/grpc/webhooks_grpc/webhooks.proto
+
+package webhooks_grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IdWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdWebhookRequest) Reset() {
+	*x = IdWebhookRequest{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdWebhookRequest) ProtoMessage() {}
+
+func (x *IdWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdWebhookRequest.ProtoReflect.Descriptor instead.
+func (*IdWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IdWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WebhookCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Generated when empty and returned once in the response.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// game.created, game.updated, game.deleted, result.created,
+	// result.updated or result.deleted, empty for every event.
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Game events only for games in these statuses, empty for any.
+	GameStatuses []string `protobuf:"bytes,4,rep,name=game_statuses,json=gameStatuses,proto3" json:"game_statuses,omitempty"`
+	// Events of this tournament only, empty for any. Only admins may leave it
+	// empty, organisers subscribe to tournaments they organise.
+	TournamentId  string `protobuf:"bytes,5,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Disabled      bool   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookCreateRequest) Reset() {
+	*x = WebhookCreateRequest{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookCreateRequest) ProtoMessage() {}
+
+func (x *WebhookCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookCreateRequest.ProtoReflect.Descriptor instead.
+func (*WebhookCreateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookCreateRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookCreateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookCreateRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookCreateRequest) GetGameStatuses() []string {
+	if x != nil {
+		return x.GameStatuses
+	}
+	return nil
+}
+
+func (x *WebhookCreateRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *WebhookCreateRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type WebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Empty keeps the current secret, it must be sent again when the url
+	// changes.
+	Secret        string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []string `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	GameStatuses  []string `protobuf:"bytes,5,rep,name=game_statuses,json=gameStatuses,proto3" json:"game_statuses,omitempty"`
+	TournamentId  string   `protobuf:"bytes,6,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Disabled      bool     `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetGameStatuses() []string {
+	if x != nil {
+		return x.GameStatuses
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type WebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Only set in the response to Create.
+	Secret       string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes   []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	GameStatuses []string               `protobuf:"bytes,5,rep,name=game_statuses,json=gameStatuses,proto3" json:"game_statuses,omitempty"`
+	TournamentId string                 `protobuf:"bytes,6,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Disabled     bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The subject that created the webhook, only it and admins manage it.
+	CreatedBy     string `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookResponse) Reset() {
+	*x = WebhookResponse{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookResponse) ProtoMessage() {}
+
+func (x *WebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookResponse.ProtoReflect.Descriptor instead.
+func (*WebhookResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetGameStatuses() []string {
+	if x != nil {
+		return x.GameStatuses
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *WebhookResponse) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *WebhookResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type WebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*WebhookResponse     `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksResponse) Reset() {
+	*x = WebhooksResponse{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksResponse) ProtoMessage() {}
+
+func (x *WebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhooksResponse.ProtoReflect.Descriptor instead.
+func (*WebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WebhooksResponse) GetWebhooks() []*WebhookResponse {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type ListDeliveriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// pending, delivered or dead, empty for every delivery.
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesRequest) Reset() {
+	*x = ListDeliveriesRequest{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DeliveryResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// Outbox sequence of the event, the same event keeps its sequence.
+	Sequence       uint64                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError      string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,8,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	// The JSON body that is posted.
+	Payload       string `protobuf:"bytes,12,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryResponse) Reset() {
+	*x = DeliveryResponse{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryResponse) ProtoMessage() {}
+
+func (x *DeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryResponse.ProtoReflect.Descriptor instead.
+func (*DeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeliveryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeliveryResponse) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *DeliveryResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DeliveryResponse) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *DeliveryResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeliveryResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeliveryResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeliveryResponse) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *DeliveryResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeliveryResponse) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *DeliveryResponse) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *DeliveryResponse) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type ListDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*DeliveryResponse    `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesResponse) Reset() {
+	*x = ListDeliveriesResponse{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDeliveriesResponse) GetDeliveries() []*DeliveryResponse {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type IdDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdDeliveryRequest) Reset() {
+	*x = IdDeliveryRequest{}
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdDeliveryRequest) ProtoMessage() {}
+
+func (x *IdDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*IdDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IdDeliveryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_internal_delivery_grpc_webhooks_grpc_webhooks_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDesc = "" +
+	"\n" +
+	"3internal/delivery/grpc/webhooks_grpc/webhooks.proto\x12\bwebhooks\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\"\n" +
+	"\x10IdWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc7\x01\n" +
+	"\x14WebhookCreateRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12#\n" +
+	"\rgame_statuses\x18\x04 \x03(\tR\fgameStatuses\x12#\n" +
+	"\rtournament_id\x18\x05 \x01(\tR\ftournamentId\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\"\xd1\x01\n" +
+	"\x0eWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12#\n" +
+	"\rgame_statuses\x18\x05 \x03(\tR\fgameStatuses\x12#\n" +
+	"\rtournament_id\x18\x06 \x01(\tR\ftournamentId\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\"\xac\x02\n" +
+	"\x0fWebhookResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12#\n" +
+	"\rgame_statuses\x18\x05 \x03(\tR\fgameStatuses\x12#\n" +
+	"\rtournament_id\x18\x06 \x01(\tR\ftournamentId\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\"I\n" +
+	"\x10WebhooksResponse\x125\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x19.webhooks.WebhookResponseR\bwebhooks\"\x8a\x01\n" +
+	"\x15ListDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xd0\x03\n" +
+	"\x10DeliveryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12'\n" +
+	"\x0fresponse_status\x18\b \x01(\x05R\x0eresponseStatus\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\x0fnext_attempt_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x12\x18\n" +
+	"\apayload\x18\f \x01(\tR\apayload\"|\n" +
+	"\x16ListDeliveriesResponse\x12:\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1a.webhooks.DeliveryResponseR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"#\n" +
+	"\x11IdDeliveryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xf3\x03\n" +
+	"\x0fWebhooksService\x12B\n" +
+	"\tFetchById\x12\x1a.webhooks.IdWebhookRequest\x1a\x19.webhooks.WebhookResponse\x12>\n" +
+	"\bFetchAll\x12\x16.google.protobuf.Empty\x1a\x1a.webhooks.WebhooksResponse\x12@\n" +
+	"\n" +
+	"DeleteById\x12\x1a.webhooks.IdWebhookRequest\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\x06Update\x12\x18.webhooks.WebhookRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x06Create\x12\x1e.webhooks.WebhookCreateRequest\x1a\x19.webhooks.WebhookResponse\x12S\n" +
+	"\x0eListDeliveries\x12\x1f.webhooks.ListDeliveriesRequest\x1a .webhooks.ListDeliveriesResponse\x12D\n" +
+	"\tRedeliver\x12\x1b.webhooks.IdDeliveryRequest\x1a\x1a.webhooks.DeliveryResponseB&Z$internal/delivery/grpc/webhooks_grpcb\x06proto3"
+
+var (
+	file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescOnce sync.Once
+	file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescData []byte
+)
+
+func file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescGZIP() []byte {
+	file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDesc), len(file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDesc)))
+	})
+	return file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDescData
+}
+
+var file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_goTypes = []any{
+	(*IdWebhookRequest)(nil),       // 0: webhooks.IdWebhookRequest
+	(*WebhookCreateRequest)(nil),   // 1: webhooks.WebhookCreateRequest
+	(*WebhookRequest)(nil),         // 2: webhooks.WebhookRequest
+	(*WebhookResponse)(nil),        // 3: webhooks.WebhookResponse
+	(*WebhooksResponse)(nil),       // 4: webhooks.WebhooksResponse
+	(*ListDeliveriesRequest)(nil),  // 5: webhooks.ListDeliveriesRequest
+	(*DeliveryResponse)(nil),       // 6: webhooks.DeliveryResponse
+	(*ListDeliveriesResponse)(nil), // 7: webhooks.ListDeliveriesResponse
+	(*IdDeliveryRequest)(nil),      // 8: webhooks.IdDeliveryRequest
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 10: google.protobuf.Empty
+}
+var file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_depIdxs = []int32{
+	9,  // 0: webhooks.WebhookResponse.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 1: webhooks.WebhooksResponse.webhooks:type_name -> webhooks.WebhookResponse
+	9,  // 2: webhooks.DeliveryResponse.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: webhooks.DeliveryResponse.next_attempt_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: webhooks.DeliveryResponse.delivered_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: webhooks.ListDeliveriesResponse.deliveries:type_name -> webhooks.DeliveryResponse
+	0,  // 6: webhooks.WebhooksService.FetchById:input_type -> webhooks.IdWebhookRequest
+	10, // 7: webhooks.WebhooksService.FetchAll:input_type -> google.protobuf.Empty
+	0,  // 8: webhooks.WebhooksService.DeleteById:input_type -> webhooks.IdWebhookRequest
+	2,  // 9: webhooks.WebhooksService.Update:input_type -> webhooks.WebhookRequest
+	1,  // 10: webhooks.WebhooksService.Create:input_type -> webhooks.WebhookCreateRequest
+	5,  // 11: webhooks.WebhooksService.ListDeliveries:input_type -> webhooks.ListDeliveriesRequest
+	8,  // 12: webhooks.WebhooksService.Redeliver:input_type -> webhooks.IdDeliveryRequest
+	3,  // 13: webhooks.WebhooksService.FetchById:output_type -> webhooks.WebhookResponse
+	4,  // 14: webhooks.WebhooksService.FetchAll:output_type -> webhooks.WebhooksResponse
+	10, // 15: webhooks.WebhooksService.DeleteById:output_type -> google.protobuf.Empty
+	10, // 16: webhooks.WebhooksService.Update:output_type -> google.protobuf.Empty
+	3,  // 17: webhooks.WebhooksService.Create:output_type -> webhooks.WebhookResponse
+	7,  // 18: webhooks.WebhooksService.ListDeliveries:output_type -> webhooks.ListDeliveriesResponse
+	6,  // 19: webhooks.WebhooksService.Redeliver:output_type -> webhooks.DeliveryResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_init() }
+func file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_init() {
+	if File_internal_delivery_grpc_webhooks_grpc_webhooks_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDesc), len(file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_grpc_webhooks_grpc_webhooks_proto = out.File
+	file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_goTypes = nil
+	file_internal_delivery_grpc_webhooks_grpc_webhooks_proto_depIdxs = nil
+}
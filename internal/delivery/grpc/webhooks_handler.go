@@ -0,0 +1,226 @@
+package grpc
+
+import (
+	"context"
+	uuid2 "github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+	"tournaments-core/internal/delivery/grpc/webhooks_grpc"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+	usecase2 "tournaments-core/internal/usecase"
+)
+
+type webhooks_server struct {
+	webhooks_grpc.UnimplementedWebhooksServiceServer
+	usecase usecase.WebhooksUseCase
+}
+
+func NewWebhooksGrpcServer(gserver *grpc.Server, rep *repository.WebhooksRepository, grants_rep *repository.TournamentGrantsRepository) {
+
+	webhooksServer := &webhooks_server{
+		usecase: usecase2.NewWebhooksUseCase(*rep, *grants_rep, 10*time.Second),
+	}
+
+	webhooks_grpc.RegisterWebhooksServiceServer(gserver, webhooksServer)
+}
+
+func (s webhooks_server) FetchById(ctx context.Context, request *webhooks_grpc.IdWebhookRequest) (*webhooks_grpc.WebhookResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	w, err := s.usecase.FetchById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toWebhookResponse(w), nil
+}
+
+func (s webhooks_server) FetchAll(ctx context.Context, _ *emptypb.Empty) (*webhooks_grpc.WebhooksResponse, error) {
+	webhooks, err := s.usecase.FetchAll(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &webhooks_grpc.WebhooksResponse{}
+	for _, w := range webhooks {
+		response.Webhooks = append(response.Webhooks, toWebhookResponse(w))
+	}
+
+	return response, nil
+}
+
+// toWebhookResponse leaves the secret out, it is only shown on creation.
+func toWebhookResponse(w models.Webhook) *webhooks_grpc.WebhookResponse {
+	var tournamentId string
+	if w.TournamentID.Valid {
+		tournamentId = w.TournamentID.UUID.String()
+	}
+
+	gameStatuses := make([]string, 0, len(w.GameStatuses))
+	for _, s := range w.GameStatuses {
+		gameStatuses = append(gameStatuses, string(s))
+	}
+
+	return &webhooks_grpc.WebhookResponse{
+		Id:           w.WebhookID.String(),
+		Url:          w.URL,
+		EventTypes:   w.EventTypes,
+		GameStatuses: gameStatuses,
+		TournamentId: tournamentId,
+		Disabled:     !w.Active,
+		CreatedBy:    w.CreatedBy,
+		CreatedAt:    timestamppb.New(w.CreatedAt),
+	}
+}
+
+func (s webhooks_server) DeleteById(ctx context.Context, request *webhooks_grpc.IdWebhookRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.usecase.DeleteById(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s webhooks_server) Update(ctx context.Context, request *webhooks_grpc.WebhookRequest) (*emptypb.Empty, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	tournamentUuid, err := parseNullUUID(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	webhook := &models.Webhook{
+		WebhookID:    uuid,
+		URL:          request.GetUrl(),
+		Secret:       request.GetSecret(),
+		EventTypes:   request.GetEventTypes(),
+		GameStatuses: parseGameStatuses(request.GetGameStatuses()),
+		TournamentID: tournamentUuid,
+		Active:       !request.GetDisabled(),
+	}
+
+	err = s.usecase.Update(ctx, webhook)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s webhooks_server) Create(ctx context.Context, request *webhooks_grpc.WebhookCreateRequest) (*webhooks_grpc.WebhookResponse, error) {
+	tournamentUuid, err := parseNullUUID(request.GetTournamentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	webhook := &models.Webhook{
+		WebhookID:    uuid2.New(),
+		URL:          request.GetUrl(),
+		Secret:       request.GetSecret(),
+		EventTypes:   request.GetEventTypes(),
+		GameStatuses: parseGameStatuses(request.GetGameStatuses()),
+		TournamentID: tournamentUuid,
+		Active:       !request.GetDisabled(),
+	}
+
+	err = s.usecase.Create(ctx, webhook)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := toWebhookResponse(*webhook)
+	response.Secret = webhook.Secret
+	return response, nil
+}
+
+func parseGameStatuses(request []string) []models.GameStatus {
+	statuses := make([]models.GameStatus, 0, len(request))
+	for _, s := range request {
+		statuses = append(statuses, models.GameStatus(s))
+	}
+	return statuses
+}
+
+func (s webhooks_server) ListDeliveries(ctx context.Context, request *webhooks_grpc.ListDeliveriesRequest) (*webhooks_grpc.ListDeliveriesResponse, error) {
+	webhookUuid, err := uuid2.Parse(request.GetWebhookId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	deliveryStatus := models.DeliveryStatus(request.GetStatus())
+	switch deliveryStatus {
+	case "", models.DeliveryPending, models.DeliveryDelivered, models.DeliveryDead:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown delivery status %q", deliveryStatus)
+	}
+
+	page, err := s.usecase.ListDeliveries(ctx, models.DeliveriesFilter{
+		WebhookID: webhookUuid,
+		Status:    deliveryStatus,
+		Page: models.PageRequest{
+			Size:  int(request.GetPageSize()),
+			Token: request.GetPageToken(),
+		},
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &webhooks_grpc.ListDeliveriesResponse{NextPageToken: page.NextPageToken}
+	for _, d := range page.Deliveries {
+		response.Deliveries = append(response.Deliveries, toDeliveryResponse(d))
+	}
+
+	return response, nil
+}
+
+func (s webhooks_server) Redeliver(ctx context.Context, request *webhooks_grpc.IdDeliveryRequest) (*webhooks_grpc.DeliveryResponse, error) {
+	uuid, err := uuid2.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	d, err := s.usecase.Redeliver(ctx, uuid)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toDeliveryResponse(d), nil
+}
+
+func toDeliveryResponse(d models.WebhookDelivery) *webhooks_grpc.DeliveryResponse {
+	response := &webhooks_grpc.DeliveryResponse{
+		Id:             d.DeliveryID.String(),
+		WebhookId:      d.WebhookID.String(),
+		Sequence:       d.Sequence,
+		EventType:      d.EventType,
+		Status:         string(d.Status),
+		Attempts:       int32(d.Attempts),
+		LastError:      d.LastError,
+		ResponseStatus: int32(d.ResponseStatus),
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+		NextAttemptAt:  timestamppb.New(d.NextAttemptAt),
+		Payload:        string(d.Payload),
+	}
+	if !d.DeliveredAt.IsZero() {
+		response.DeliveredAt = timestamppb.New(d.DeliveredAt)
+	}
+	return response
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Kinds of domain errors. Every *Error matches exactly one of them with
+// errors.Is, the delivery layer picks the status code from the kind.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrConflict        = errors.New("conflict")
+	ErrUnavailable     = errors.New("unavailable")
+	// ErrUnauthenticated is a caller without valid credentials,
+	// ErrPermissionDenied a known caller that may not do what it asked.
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// ErrStaleVersion is returned when an entity was changed after the version
+// the caller based its change on.
+var ErrStaleVersion = &Error{
+	Kind:    ErrConflict,
+	Reason:  "STALE_VERSION",
+	Message: "version is stale, the entity was changed concurrently",
+}
+
+// Error is a domain error of a known kind. Resource names the type of the
+// entity the error is about when it is known, Reason is a short constant
+// clients can switch on and Violations list the request fields at fault.
+type Error struct {
+	Kind       error
+	Reason     string
+	Resource   string
+	Message    string
+	Violations []FieldViolation
+	Err        error
+}
+
+// FieldViolation names a request field, by its json name, that breaks a rule.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NotFound reports a missing entity of the resource type.
+func NotFound(resource string, id any) error {
+	return &Error{
+		Kind:     ErrNotFound,
+		Reason:   "NOT_FOUND",
+		Resource: resource,
+		Message:  fmt.Sprintf("%s %v not found", resource, id),
+	}
+}
+
+func InvalidArgument(format string, args ...any) error {
+	return &Error{Kind: ErrInvalidArgument, Reason: "INVALID_ARGUMENT", Message: fmt.Sprintf(format, args...)}
+}
+
+// Conflict reports a request that does not fit the current state of an
+// entity, such as drawing a bracket twice.
+func Conflict(format string, args ...any) error {
+	return &Error{Kind: ErrConflict, Reason: "CONFLICT", Message: fmt.Sprintf(format, args...)}
+}
+
+func Unauthenticated(format string, args ...any) error {
+	return &Error{Kind: ErrUnauthenticated, Reason: "UNAUTHENTICATED", Message: fmt.Sprintf(format, args...)}
+}
+
+func PermissionDenied(format string, args ...any) error {
+	return &Error{Kind: ErrPermissionDenied, Reason: "PERMISSION_DENIED", Message: fmt.Sprintf(format, args...)}
+}
+
+// Invalid reports request fields that break validation rules.
+func Invalid(violations ...FieldViolation) error {
+	return &Error{
+		Kind:       ErrInvalidArgument,
+		Reason:     "VALIDATION_FAILED",
+		Message:    violationsMessage(violations),
+		Violations: violations,
+	}
+}
+
+func violationsMessage(violations []FieldViolation) string {
+	parts := make([]string, 0, len(violations))
+	for _, v := range violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return strings.Join(parts, "; ")
+}
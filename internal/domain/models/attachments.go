@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AttachmentKind string
+
+const (
+	AttachmentScreenshot AttachmentKind = "screenshot"
+	AttachmentReplay     AttachmentKind = "replay"
+	AttachmentDemoLink   AttachmentKind = "demo_link"
+)
+
+var AttachmentKinds = []AttachmentKind{AttachmentScreenshot, AttachmentReplay, AttachmentDemoLink}
+
+// Attachment is evidence backing a result or a participant's report of one,
+// exactly one of ResultID and ReportID is set. Screenshots and replays are
+// files kept in the blob store under StorageKey, demo links only point at
+// URL. SHA256 is the hex digest of the file.
+type Attachment struct {
+	AttachmentID uuid.UUID      `json:"attachment_id"`
+	ResultID     uuid.NullUUID  `json:"result_id"`
+	ReportID     uuid.NullUUID  `json:"report_id"`
+	Kind         AttachmentKind `json:"kind"`
+	Name         string         `json:"name"`
+	ContentType  string         `json:"content_type"`
+	Size         int64          `json:"size"`
+	SHA256       string         `json:"sha256"`
+	URL          string         `json:"url"`
+	StorageKey   string         `json:"-"`
+	UploadedBy   string         `json:"uploaded_by"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+// HasContent reports whether the attachment is a file rather than a link.
+func (a Attachment) HasContent() bool {
+	return a.Kind != AttachmentDemoLink
+}
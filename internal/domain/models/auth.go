@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Role string
+
+const (
+	RoleAdmin     Role = "admin"
+	RoleOrganiser Role = "organiser"
+	RoleReferee   Role = "referee"
+	RoleViewer    Role = "viewer"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Subject   string    `json:"subject"`
+	Roles     []Role    `json:"roles"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (p Principal) HasRole(role Role) bool {
+	return contains(p.Roles, role)
+}
+
+// HasAnyRole reports whether the principal has one of the roles, admins have
+// all of them.
+func (p Principal) HasAnyRole(roles ...Role) bool {
+	if p.HasRole(RoleAdmin) {
+		return true
+	}
+	for _, r := range roles {
+		if p.HasRole(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// GrantAccess is what a grant allows on a tournament. Owners and
+// co-organisers manage its games and results, owners also manage its grants,
+// referees run its games and record their results.
+type GrantAccess string
+
+const (
+	AccessOwner       GrantAccess = "owner"
+	AccessCoOrganiser GrantAccess = "co_organiser"
+	AccessReferee     GrantAccess = "referee"
+)
+
+// TournamentGrant gives the subject of a token access to a tournament.
+type TournamentGrant struct {
+	TournamentID uuid.UUID   `json:"tournament_id"`
+	Subject      string      `json:"subject"`
+	Access       GrantAccess `json:"access"`
+	GrantedBy    string      `json:"granted_by"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
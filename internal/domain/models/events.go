@@ -0,0 +1,106 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventCreated EventType = "created"
+	EventUpdated EventType = "updated"
+	EventDeleted EventType = "deleted"
+)
+
+type EventEntity string
+
+const (
+	EntityGame       EventEntity = "game"
+	EntityResult     EventEntity = "result"
+	EntityTournament EventEntity = "tournament"
+)
+
+// Event is a change of a game, result or tournament. Exactly one of Game,
+// Result and Tournament is set, deleted entities carry their last state.
+// Sequence is the position of the event in the outbox, GameID and TournamentID
+// tie the changed entity to the game and tournament it belongs to.
+type Event struct {
+	Sequence     uint64        `json:"sequence"`
+	Type         EventType     `json:"type"`
+	Entity       EventEntity   `json:"entity"`
+	EntityID     uuid.UUID     `json:"entity_id"`
+	GameID       uuid.NullUUID `json:"game_id"`
+	TournamentID uuid.NullUUID `json:"tournament_id"`
+	At           time.Time     `json:"at"`
+	Game         *Game         `json:"game,omitempty"`
+	Result       *Result       `json:"result,omitempty"`
+	Tournament   *Tournament   `json:"tournament,omitempty"`
+}
+
+// Name is the entity and type of the event, such as "game.created".
+func (e Event) Name() string {
+	return string(e.Entity) + "." + string(e.Type)
+}
+
+func GameEvent(t EventType, g Game) Event {
+	return Event{
+		Type:         t,
+		Entity:       EntityGame,
+		EntityID:     g.GameID,
+		GameID:       uuid.NullUUID{UUID: g.GameID, Valid: true},
+		TournamentID: g.TournamentID,
+		Game:         &g,
+	}
+}
+
+// ResultEvent takes the tournament of the result's game, which the result
+// itself does not know.
+func ResultEvent(t EventType, r Result, tournamentID uuid.NullUUID) Event {
+	return Event{
+		Type:         t,
+		Entity:       EntityResult,
+		EntityID:     r.ResultID,
+		GameID:       uuid.NullUUID{UUID: r.GameID, Valid: true},
+		TournamentID: tournamentID,
+		Result:       &r,
+	}
+}
+
+func TournamentEvent(t EventType, tournament Tournament) Event {
+	return Event{
+		Type:         t,
+		Entity:       EntityTournament,
+		EntityID:     tournament.TournamentID,
+		TournamentID: uuid.NullUUID{UUID: tournament.TournamentID, Valid: true},
+		Tournament:   &tournament,
+	}
+}
+
+// EventFilter selects events, zero fields are not applied.
+type EventFilter struct {
+	Entity       EventEntity   `json:"entity"`
+	GameID       uuid.NullUUID `json:"game_id"`
+	TournamentID uuid.NullUUID `json:"tournament_id"`
+}
+
+func (f EventFilter) Match(e Event) bool {
+	if f.Entity != "" && f.Entity != e.Entity {
+		return false
+	}
+	if f.GameID.Valid && e.GameID != f.GameID {
+		return false
+	}
+	if f.TournamentID.Valid && e.TournamentID != f.TournamentID {
+		return false
+	}
+	return true
+}
+
+// OutboxEvent is an event stored in the outbox along with the number of
+// failed attempts to deliver it. Its Sequence is the outbox position, which
+// stays the same across redeliveries.
+type OutboxEvent struct {
+	Event
+	Attempts int `json:"attempts"`
+}
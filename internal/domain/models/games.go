@@ -5,13 +5,89 @@ import (
 	"time"
 )
 
+type BracketSide string
+
+const (
+	BracketWinners    BracketSide = "winners"
+	BracketLosers     BracketSide = "losers"
+	BracketGrandFinal BracketSide = "grand_final"
+	BracketGroup      BracketSide = "group"
+	BracketSwiss      BracketSide = "swiss"
+)
+
 type Game struct {
-	GameID     uuid.UUID `json:"game_id"`
-	GameStart  time.Time `json:"game_start"`
-	GameTypeID uuid.UUID `json:"game_type_id"`
+	GameID       uuid.UUID         `json:"game_id"`
+	GameStart    time.Time         `json:"game_start"`
+	GameTypeID   uuid.UUID         `json:"game_type_id"`
+	PlatformName string            `json:"platform_name"`
+	Status       GameStatus        `json:"status"`
+	TournamentID uuid.NullUUID     `json:"tournament_id"`
+	Bracket      BracketSide       `json:"bracket"`
+	Group        int               `json:"group"`
+	Round        int               `json:"round"`
+	Position     int               `json:"position"`
+	Participants []GameParticipant `json:"participants"`
+	WinnerNext   NextSlot          `json:"winner_next"`
+	LoserNext    NextSlot          `json:"loser_next"`
+	Version      int64             `json:"version"`
+}
+
+// GameParticipant places a participant into a numbered slot of a game.
+// Bracket games have slots 0 and 1; a slot that is not yet decided is
+// simply absent.
+type GameParticipant struct {
+	ParticipantID uuid.UUID `json:"participant_id"`
+	Slot          int       `json:"slot"`
+}
+
+// NextSlot is the game slot a participant moves into once a game is decided.
+// An invalid GameID means the participant does not move on.
+type NextSlot struct {
+	GameID uuid.NullUUID `json:"game_id"`
+	Slot   int           `json:"slot"`
 }
 
 type GameType struct {
 	GameTypeID   uuid.UUID `json:"game_type_id"`
 	PlatformName string    `json:"platform_name"`
 }
+
+// GameStatus is the stage of a game's lifecycle. Games are scheduled when
+// created, may open check-in, go live and finish once their result is in.
+// Scheduled games can be postponed and rescheduled, and any game that has not
+// finished can be cancelled.
+type GameStatus string
+
+const (
+	GameScheduled GameStatus = "scheduled"
+	GameCheckIn   GameStatus = "check_in"
+	GameLive      GameStatus = "live"
+	GameFinished  GameStatus = "finished"
+	GameCancelled GameStatus = "cancelled"
+	GamePostponed GameStatus = "postponed"
+)
+
+type GameSort string
+
+const (
+	GameSortStart GameSort = "game_start"
+	GameSortRound GameSort = "round"
+)
+
+// GamesFilter narrows a game listing, zero fields are not applied.
+// StartTo is exclusive.
+type GamesFilter struct {
+	StartFrom    time.Time     `json:"start_from"`
+	StartTo      time.Time     `json:"start_to"`
+	GameTypeID   uuid.NullUUID `json:"game_type_id"`
+	TournamentID uuid.NullUUID `json:"tournament_id"`
+	Status       GameStatus    `json:"status"`
+	Sort         GameSort      `json:"sort"`
+	Descending   bool          `json:"descending"`
+	Page         PageRequest   `json:"page"`
+}
+
+type GamesPage struct {
+	Games         []Game `json:"games"`
+	NextPageToken string `json:"next_page_token"`
+}
@@ -0,0 +1,24 @@
+package models
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// PageRequest asks for one page of a listing. Token is the opaque cursor
+// returned with the previous page, an empty token starts from the beginning.
+type PageRequest struct {
+	Size  int    `json:"size"`
+	Token string `json:"token"`
+}
+
+// Limit clamps the requested size into (0, MaxPageSize].
+func (p PageRequest) Limit() int {
+	switch {
+	case p.Size <= 0:
+		return DefaultPageSize
+	case p.Size > MaxPageSize:
+		return MaxPageSize
+	}
+	return p.Size
+}
@@ -0,0 +1,27 @@
+package models
+
+import "github.com/google/uuid"
+
+type ParticipantKind string
+
+const (
+	ParticipantPlayer ParticipantKind = "player"
+	ParticipantTeam   ParticipantKind = "team"
+)
+
+// Participant is whoever takes a slot in a game: a single player or a team.
+// Subject is the token subject of the account that reports results for the
+// participant, any member of a team may report for it.
+type Participant struct {
+	ParticipantID uuid.UUID       `json:"participant_id"`
+	Name          string          `json:"name"`
+	Kind          ParticipantKind `json:"kind"`
+	Subject       string          `json:"subject"`
+	Members       []TeamMember    `json:"members"`
+}
+
+// TeamMember is a player on a team roster.
+type TeamMember struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	Role     string    `json:"role"`
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ReviewStatus is where the result of a game stands while its participants
+// report it: reported until every side has, then confirmed when the reports
+// match or disputed until a referee resolves it.
+type ReviewStatus string
+
+const (
+	ReviewReported  ReviewStatus = "reported"
+	ReviewConfirmed ReviewStatus = "confirmed"
+	ReviewDisputed  ReviewStatus = "disputed"
+	ReviewResolved  ReviewStatus = "resolved"
+)
+
+// Final reports whether the review has led to a result.
+func (s ReviewStatus) Final() bool {
+	return s == ReviewConfirmed || s == ReviewResolved
+}
+
+// ResultReport is one participant's version of how a game ended, the fields
+// follow those of a Result.
+type ResultReport struct {
+	ReportID      uuid.UUID     `json:"report_id"`
+	GameID        uuid.UUID     `json:"game_id"`
+	ParticipantID uuid.UUID     `json:"participant_id"`
+	Subject       string        `json:"subject"`
+	WinnerID      uuid.UUID     `json:"winner_id"`
+	Placements    []Placement   `json:"placements"`
+	Outcome       ResultOutcome `json:"outcome"`
+	ForfeitedBy   uuid.NullUUID `json:"forfeited_by"`
+	Comment       string        `json:"comment"`
+	CreatedAt     time.Time     `json:"created_at"`
+	Attachments   []Attachment  `json:"attachments"`
+}
+
+// Result is the result the report claims.
+func (r ResultReport) Result() Result {
+	return Result{
+		GameID:      r.GameID,
+		WinnerID:    r.WinnerID,
+		Comment:     r.Comment,
+		Placements:  r.Placements,
+		Outcome:     r.Outcome,
+		ForfeitedBy: r.ForfeitedBy,
+	}
+}
+
+// DisputeComment is a note left on a review by a participant or an official.
+type DisputeComment struct {
+	CommentID uuid.UUID `json:"comment_id"`
+	GameID    uuid.UUID `json:"game_id"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ResultReview collects the reports of a game until its result is agreed on.
+// ResultID is the result it led to, ResolvedBy and Resolution record the
+// referee that settled a dispute.
+type ResultReview struct {
+	GameID     uuid.UUID        `json:"game_id"`
+	Status     ReviewStatus     `json:"status"`
+	ResultID   uuid.NullUUID    `json:"result_id"`
+	ResolvedBy string           `json:"resolved_by"`
+	Resolution string           `json:"resolution"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+	Reports    []ResultReport   `json:"reports"`
+	Comments   []DisputeComment `json:"comments"`
+}
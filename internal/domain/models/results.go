@@ -1,10 +1,74 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
+type ResultOutcome string
+
+const (
+	OutcomeWin              ResultOutcome = "win"
+	OutcomeDraw             ResultOutcome = "draw"
+	OutcomeForfeit          ResultOutcome = "forfeit"
+	OutcomeDisqualification ResultOutcome = "disqualification"
+	OutcomeCancelled        ResultOutcome = "cancelled"
+)
+
+// Result of a game. WinnerID is the first participant placed first, it is
+// kept for clients that only know about a single winner and is uuid.Nil when
+// nobody won. ForfeitedBy names who forfeited or was disqualified. Version
+// grows with every change.
 type Result struct {
-	ResultID uuid.UUID `json:"result_id"`
-	GameID   uuid.UUID `json:"game_id"`
-	WinnerID uuid.UUID `json:"winner_id"`
-	Comment  string    `json:"comment"`
+	ResultID    uuid.UUID     `json:"result_id"`
+	GameID      uuid.UUID     `json:"game_id"`
+	WinnerID    uuid.UUID     `json:"winner_id"`
+	Comment     string        `json:"comment"`
+	Placements  []Placement   `json:"placements"`
+	Outcome     ResultOutcome `json:"outcome"`
+	ForfeitedBy uuid.NullUUID `json:"forfeited_by"`
+	CreatedAt   time.Time     `json:"created_at"`
+	Version     int64         `json:"version"`
+}
+
+// Placement is where a participant finished in a game along with its score.
+// Tied participants share a place and the next place is skipped, so a tie
+// for third is followed by fifth.
+type Placement struct {
+	ParticipantID uuid.UUID `json:"participant_id"`
+	Place         int       `json:"place"`
+	Score         float64   `json:"score"`
+}
+
+// Winners returns every participant placed first. Draws and cancelled games
+// have no winners.
+func (r Result) Winners() []uuid.UUID {
+	if r.Outcome == OutcomeDraw || r.Outcome == OutcomeCancelled {
+		return nil
+	}
+
+	var winners []uuid.UUID
+	for _, p := range r.Placements {
+		if p.Place == 1 {
+			winners = append(winners, p.ParticipantID)
+		}
+	}
+	return winners
+}
+
+// ResultsFilter narrows a result listing, zero fields are not applied.
+// WinnerID matches every participant placed first, so it follows Winners.
+// CreatedTo is exclusive.
+type ResultsFilter struct {
+	GameID      uuid.NullUUID `json:"game_id"`
+	WinnerID    uuid.NullUUID `json:"winner_id"`
+	CreatedFrom time.Time     `json:"created_from"`
+	CreatedTo   time.Time     `json:"created_to"`
+	Descending  bool          `json:"descending"`
+	Page        PageRequest   `json:"page"`
+}
+
+type ResultsPage struct {
+	Results       []Result `json:"results"`
+	NextPageToken string   `json:"next_page_token"`
 }
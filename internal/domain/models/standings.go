@@ -0,0 +1,69 @@
+package models
+
+import "github.com/google/uuid"
+
+// Tiebreak separates participants level on points in a standings table.
+type Tiebreak string
+
+const (
+	// TiebreakHeadToHead compares the points the tied participants took from
+	// each other.
+	TiebreakHeadToHead Tiebreak = "head_to_head"
+	// TiebreakBuchholz compares the sum of the opponents' points.
+	TiebreakBuchholz Tiebreak = "buchholz"
+	// TiebreakScoreDiff compares the scores made minus the scores conceded.
+	TiebreakScoreDiff Tiebreak = "score_diff"
+	// TiebreakRandom draws lots. The draw is the same every time the table of
+	// a tournament is computed, so pages stay consistent.
+	TiebreakRandom Tiebreak = "random"
+)
+
+// StandingsRules are the points a win, draw and loss are worth and the
+// tiebreaks applied in order to participants level on points. Participants
+// still level after the last tiebreak share their rank.
+type StandingsRules struct {
+	Win       float64    `json:"win"`
+	Draw      float64    `json:"draw"`
+	Loss      float64    `json:"loss"`
+	Tiebreaks []Tiebreak `json:"tiebreaks"`
+}
+
+// DefaultStandingsRules score three points for a win and one for a draw and
+// break ties by head-to-head, then score difference, then Buchholz.
+var DefaultStandingsRules = StandingsRules{
+	Win:       3,
+	Draw:      1,
+	Tiebreaks: []Tiebreak{TiebreakHeadToHead, TiebreakScoreDiff, TiebreakBuchholz},
+}
+
+// StandingsQuery asks for one page of a tournament table. Group narrows it to
+// one group of a group stage, zero takes every game of the tournament.
+type StandingsQuery struct {
+	TournamentID uuid.UUID      `json:"tournament_id"`
+	Group        int            `json:"group"`
+	Rules        StandingsRules `json:"rules"`
+	Page         PageRequest    `json:"page"`
+}
+
+// StandingRow is a participant's line in a table. Only games with a result
+// count, cancelled games are left out and a Swiss bye counts as a win.
+// ScoreAgainst sums the scores of the other participants of each game.
+type StandingRow struct {
+	Rank          int       `json:"rank"`
+	ParticipantID uuid.UUID `json:"participant_id"`
+	Played        int       `json:"played"`
+	Wins          int       `json:"wins"`
+	Draws         int       `json:"draws"`
+	Losses        int       `json:"losses"`
+	Points        float64   `json:"points"`
+	ScoreFor      float64   `json:"score_for"`
+	ScoreAgainst  float64   `json:"score_against"`
+	ScoreDiff     float64   `json:"score_diff"`
+	Buchholz      float64   `json:"buchholz"`
+}
+
+type StandingsPage struct {
+	Rows          []StandingRow `json:"rows"`
+	Total         int           `json:"total"`
+	NextPageToken string        `json:"next_page_token"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type TournamentFormat string
+
+const (
+	FormatSingleElimination TournamentFormat = "single_elimination"
+	FormatDoubleElimination TournamentFormat = "double_elimination"
+	FormatRoundRobin        TournamentFormat = "round_robin"
+	FormatGroupStage        TournamentFormat = "group_stage"
+	FormatSwiss             TournamentFormat = "swiss"
+)
+
+type TournamentStatus string
+
+const (
+	TournamentDraft     TournamentStatus = "draft"
+	TournamentScheduled TournamentStatus = "scheduled"
+	TournamentRunning   TournamentStatus = "running"
+	TournamentFinished  TournamentStatus = "finished"
+	TournamentCancelled TournamentStatus = "cancelled"
+)
+
+type Tournament struct {
+	TournamentID uuid.UUID        `json:"tournament_id"`
+	Name         string           `json:"name"`
+	GameTypeID   uuid.UUID        `json:"game_type_id"`
+	Format       TournamentFormat `json:"format"`
+	StartsAt     time.Time        `json:"starts_at"`
+	EndsAt       time.Time        `json:"ends_at"`
+	Status       TournamentStatus `json:"status"`
+	// GrandFinalReset plays a second grand final in double elimination
+	// when the losers bracket finalist wins the first one.
+	GrandFinalReset bool `json:"grand_final_reset"`
+	// SlotLength is the time between the starts of consecutive rounds.
+	SlotLength time.Duration `json:"slot_length"`
+	// Legs is how many times everyone meets everyone in round robin,
+	// 1 for a single and 2 for a double round robin.
+	Legs int `json:"legs"`
+	// Groups and GroupAdvance configure the group stage: participants are
+	// split into Groups groups and the top GroupAdvance of each one play
+	// the single-elimination playoff.
+	Groups       int `json:"groups"`
+	GroupAdvance int `json:"group_advance"`
+	// SwissRounds is the number of Swiss rounds, zero picks enough rounds
+	// to separate a single winner.
+	SwissRounds int `json:"swiss_rounds"`
+}
+
+// SwissStanding is a participant's line in a Swiss table. A win scores one
+// point, a bye counts as a win.
+type SwissStanding struct {
+	ParticipantID   uuid.UUID `json:"participant_id"`
+	Score           float64   `json:"score"`
+	Buchholz        float64   `json:"buchholz"`
+	SonnebornBerger float64   `json:"sonneborn_berger"`
+}
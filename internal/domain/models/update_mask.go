@@ -0,0 +1,44 @@
+package models
+
+import "fmt"
+
+// UpdateMask lists the fields an update writes by their json names. Fields
+// outside the mask keep their stored values, an empty mask keeps the
+// behaviour of updates sent without one.
+type UpdateMask []string
+
+func (m UpdateMask) Has(field string) bool {
+	for _, f := range m {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAny reports whether the mask names at least one of the fields.
+func (m UpdateMask) HasAny(fields ...string) bool {
+	for _, f := range fields {
+		if m.Has(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check rejects fields that an update of the entity cannot write.
+func (m UpdateMask) Check(updatable ...string) error {
+	for _, f := range m {
+		known := false
+		for _, u := range updatable {
+			if f == u {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("Field %q cannot be updated", f)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"net/netip"
+	"time"
+)
+
+// Webhook subscribes a URL to events. EventTypes holds event names such as
+// "game.created" or "result.created" and GameStatuses narrows game events
+// down to games in those statuses, so that ["game.updated"] with ["live"]
+// reports games going live. Empty lists and an invalid TournamentID match
+// everything. Payloads are signed with Secret. CreatedBy is the subject that
+// created the webhook, only it and admins manage the webhook.
+type Webhook struct {
+	WebhookID    uuid.UUID     `json:"webhook_id"`
+	URL          string        `json:"url"`
+	Secret       string        `json:"-"`
+	EventTypes   []string      `json:"event_types"`
+	GameStatuses []GameStatus  `json:"game_statuses"`
+	TournamentID uuid.NullUUID `json:"tournament_id"`
+	Active       bool          `json:"active"`
+	CreatedBy    string        `json:"created_by"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+// WebhookEventTypes are the event names a webhook can subscribe to.
+var WebhookEventTypes = []string{
+	"game.created", "game.updated", "game.deleted",
+	"result.created", "result.updated", "result.deleted",
+}
+
+// Matches reports whether the event is sent to the webhook. Only the events
+// of WebhookEventTypes are, tournament events stay inside the service.
+func (w Webhook) Matches(e Event) bool {
+	if !w.Active || !contains(WebhookEventTypes, e.Name()) {
+		return false
+	}
+	if w.TournamentID.Valid && e.TournamentID != w.TournamentID {
+		return false
+	}
+	if len(w.EventTypes) > 0 && !contains(w.EventTypes, e.Name()) {
+		return false
+	}
+	if len(w.GameStatuses) > 0 && e.Game != nil && !contains(w.GameStatuses, e.Game.Status) {
+		return false
+	}
+	return true
+}
+
+// PublicWebhookAddr reports whether webhooks may be sent to the address.
+// Loopback, private, link-local and unspecified addresses reach the network
+// the service runs in and are refused.
+func PublicWebhookAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() && !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast()
+}
+
+func contains[T comparable](list []T, v T) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead is a delivery that ran out of attempts, it stays in the
+	// dead-letter list until it is redelivered.
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// WebhookDelivery is one event sent to one webhook. Sequence is the outbox
+// sequence of the event, an event is delivered to a webhook once however
+// often the outbox relays it. URL and Secret are the webhook's at the time the
+// delivery is attempted.
+type WebhookDelivery struct {
+	DeliveryID     uuid.UUID      `json:"delivery_id"`
+	WebhookID      uuid.UUID      `json:"webhook_id"`
+	Sequence       uint64         `json:"sequence"`
+	EventType      string         `json:"event_type"`
+	Payload        []byte         `json:"payload"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	LastError      string         `json:"last_error"`
+	ResponseStatus int            `json:"response_status"`
+	CreatedAt      time.Time      `json:"created_at"`
+	NextAttemptAt  time.Time      `json:"next_attempt_at"`
+	DeliveredAt    time.Time      `json:"delivered_at"`
+	URL            string         `json:"-"`
+	Secret         string         `json:"-"`
+}
+
+// DeliveriesFilter narrows the delivery history of a webhook, an empty
+// status lists every delivery.
+type DeliveriesFilter struct {
+	WebhookID uuid.UUID      `json:"webhook_id"`
+	Status    DeliveryStatus `json:"status"`
+	Page      PageRequest    `json:"page"`
+}
+
+type DeliveriesPage struct {
+	Deliveries    []WebhookDelivery `json:"deliveries"`
+	NextPageToken string            `json:"next_page_token"`
+}
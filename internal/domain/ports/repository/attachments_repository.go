@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type AttachmentsRepository interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.Attachment, error)
+	FetchByResult(ctx context.Context, resultId uuid.UUID) ([]models.Attachment, error)
+	Create(ctx context.Context, a *models.Attachment) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+}
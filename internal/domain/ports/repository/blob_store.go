@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore keeps the files attached to results. Keys are chosen by the
+// service and only hold letters, digits, dashes and slashes.
+type BlobStore interface {
+	// Put stores everything read from r under the key, replacing what was
+	// there. Nothing is stored when reading fails.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get opens the blob, reading fails once ctx is done.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, removing a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
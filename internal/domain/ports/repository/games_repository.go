@@ -9,6 +9,10 @@ import (
 type GamesRepository interface {
 	FetchById(ctx context.Context, id uuid.UUID) (models.Game, error)
 	Update(ctx context.Context, updated *models.Game) error
-	DeleteById(ctx context.Context, id uuid.UUID) error
+	DeleteById(ctx context.Context, id uuid.UUID, version int64) error
 	Create(ctx context.Context, g *models.Game) error
+	CreateMany(ctx context.Context, games []models.Game) error
+	FetchByTournament(ctx context.Context, tournamentId uuid.UUID) ([]models.Game, error)
+	SetParticipant(ctx context.Context, gameId uuid.UUID, p models.GameParticipant) error
+	List(ctx context.Context, filter models.GamesFilter) (models.GamesPage, error)
 }
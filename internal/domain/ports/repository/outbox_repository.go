@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"tournaments-core/internal/domain/models"
+)
+
+type OutboxRepository interface {
+	// Claim takes up to limit undelivered events in outbox order and hides
+	// them from other claims for the lease, so that an event is claimed again
+	// only when it was neither delivered nor retried in time.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]models.OutboxEvent, error)
+	// Extend renews the lease of claimed events that are still undelivered.
+	Extend(ctx context.Context, sequences []uint64, lease time.Duration) error
+	MarkDelivered(ctx context.Context, sequence uint64) error
+	// Retry records a failed delivery and makes the event claimable after
+	// the delay.
+	Retry(ctx context.Context, sequence uint64, delay time.Duration, cause string) error
+	// Since returns up to limit events after the sequence in outbox order,
+	// whether they were delivered or not.
+	Since(ctx context.Context, after uint64, limit int) ([]models.Event, error)
+	// Last returns the sequence of the latest event, zero when there is none.
+	Last(ctx context.Context) (uint64, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type ParticipantsRepository interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.Participant, error)
+	Update(ctx context.Context, updated *models.Participant) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, p *models.Participant) error
+	// Tournaments returns the tournaments whose games the participant plays
+	// in, itself or on one of its teams.
+	Tournaments(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+}
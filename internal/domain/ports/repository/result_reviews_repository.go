@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type ResultReviewsRepository interface {
+	// FetchByGame returns the review with its reports, their attachments and
+	// the comments left on it.
+	FetchByGame(ctx context.Context, gameId uuid.UUID) (models.ResultReview, error)
+	FetchReport(ctx context.Context, id uuid.UUID) (models.ResultReport, error)
+	// SaveReport opens the review of the game when it has none and replaces
+	// the participant's earlier report, which keeps its id.
+	SaveReport(ctx context.Context, r *models.ResultReport) error
+	AddComment(ctx context.Context, c *models.DisputeComment) error
+	// SetStatus writes the status, result and resolution of the review.
+	SetStatus(ctx context.Context, review *models.ResultReview) error
+	DeleteByGame(ctx context.Context, gameId uuid.UUID) error
+}
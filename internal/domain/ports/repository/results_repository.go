@@ -9,6 +9,9 @@ import (
 type ResultsRepository interface {
 	FetchById(ctx context.Context, id uuid.UUID) (models.Result, error)
 	Update(ctx context.Context, updated *models.Result) error
-	DeleteById(ctx context.Context, id uuid.UUID) error
+	DeleteById(ctx context.Context, id uuid.UUID, version int64) error
 	Create(ctx context.Context, r *models.Result) error
+	FetchByTournament(ctx context.Context, tournamentId uuid.UUID) ([]models.Result, error)
+	FetchByGameId(ctx context.Context, gameId uuid.UUID) (models.Result, error)
+	List(ctx context.Context, filter models.ResultsFilter) (models.ResultsPage, error)
 }
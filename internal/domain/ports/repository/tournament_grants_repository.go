@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type TournamentGrantsRepository interface {
+	Fetch(ctx context.Context, tournamentId uuid.UUID, subject string) (models.TournamentGrant, error)
+	FetchByTournament(ctx context.Context, tournamentId uuid.UUID) ([]models.TournamentGrant, error)
+	// Grant stores the grant, replacing the subject's access to the
+	// tournament if it had one.
+	Grant(ctx context.Context, g *models.TournamentGrant) error
+	Revoke(ctx context.Context, tournamentId uuid.UUID, subject string) error
+}
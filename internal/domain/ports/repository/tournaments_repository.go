@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type TournamentsRepository interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.Tournament, error)
+	Update(ctx context.Context, updated *models.Tournament) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, t *models.Tournament) error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// Transactor runs work spanning several repositories in one transaction.
+// Repositories called with the context fn is given join the transaction, it
+// is committed when fn returns nil and rolled back otherwise. Nested calls
+// join the outer transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
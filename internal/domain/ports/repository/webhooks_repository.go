@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+	"tournaments-core/internal/domain/models"
+)
+
+type WebhooksRepository interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.Webhook, error)
+	FetchAll(ctx context.Context) ([]models.Webhook, error)
+	Update(ctx context.Context, updated *models.Webhook) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, w *models.Webhook) error
+
+	// Enqueue stores pending deliveries, a delivery of an event that the
+	// webhook already has is skipped.
+	Enqueue(ctx context.Context, deliveries []models.WebhookDelivery) error
+	// ClaimDeliveries takes up to limit pending deliveries that are due and
+	// hides them from other claims for the lease.
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.WebhookDelivery, error)
+	// SaveAttempt records the outcome of an attempt: Status, Attempts,
+	// LastError, ResponseStatus, NextAttemptAt and DeliveredAt are written.
+	SaveAttempt(ctx context.Context, d *models.WebhookDelivery) error
+	ListDeliveries(ctx context.Context, filter models.DeliveriesFilter) (models.DeliveriesPage, error)
+	FetchDelivery(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error)
+	// Redeliver makes a dead delivery pending again with fresh attempts.
+	Redeliver(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error)
+}
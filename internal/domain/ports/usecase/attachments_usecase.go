@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"io"
+	"tournaments-core/internal/domain/models"
+)
+
+type AttachmentsUseCase interface {
+	FetchByResult(ctx context.Context, resultId uuid.UUID) ([]models.Attachment, error)
+	// Upload stores the file read from r and attaches it to the result.
+	Upload(ctx context.Context, a *models.Attachment, r io.Reader) error
+	AddLink(ctx context.Context, a *models.Attachment) error
+	// Download opens the file of an attachment. The file is read with ctx
+	// rather than under the use case timeout, so large files can take as
+	// long as the client needs.
+	Download(ctx context.Context, id uuid.UUID) (models.Attachment, io.ReadCloser, error)
+	DeleteById(ctx context.Context, id uuid.UUID) error
+}
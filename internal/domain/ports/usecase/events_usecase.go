@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+	"tournaments-core/internal/domain/models"
+)
+
+type EventsUseCase interface {
+	// Watch sends events matching the filter until ctx is done or send
+	// fails. Events after the given sequence are sent first, zero starts
+	// with the next event.
+	Watch(ctx context.Context, filter models.EventFilter, after uint64, send func(models.Event) error) error
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type GameTypesUseCase interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.GameType, error)
+	FetchAll(ctx context.Context) ([]models.GameType, error)
+	Update(ctx context.Context, updated *models.GameType) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, gt *models.GameType) error
+}
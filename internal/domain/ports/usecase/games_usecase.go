@@ -3,12 +3,18 @@ package usecase
 import (
 	"context"
 	"github.com/google/uuid"
+	"time"
 	"tournaments-core/internal/domain/models"
 )
 
 type GamesUseCase interface {
 	FetchById(ctx context.Context, id uuid.UUID) (models.Game, error)
-	Update(ctx context.Context, updated *models.Game) error
-	DeleteById(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, updated *models.Game, mask models.UpdateMask) error
+	DeleteById(ctx context.Context, id uuid.UUID, version int64) error
 	Create(ctx context.Context, g *models.Game) error
+	List(ctx context.Context, filter models.GamesFilter) (models.GamesPage, error)
+	OpenCheckIn(ctx context.Context, id uuid.UUID, version int64) (models.Game, error)
+	Start(ctx context.Context, id uuid.UUID, version int64) (models.Game, error)
+	Cancel(ctx context.Context, id uuid.UUID, version int64) (models.Game, error)
+	Postpone(ctx context.Context, id uuid.UUID, start time.Time, version int64) (models.Game, error)
 }
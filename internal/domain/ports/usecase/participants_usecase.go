@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type ParticipantsUseCase interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.Participant, error)
+	Update(ctx context.Context, updated *models.Participant) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, p *models.Participant) error
+}
@@ -8,7 +8,15 @@ import (
 
 type ResultsUseCase interface {
 	FetchById(ctx context.Context, id uuid.UUID) (models.Result, error)
-	Update(ctx context.Context, updated *models.Result) error
-	DeleteById(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, updated *models.Result, mask models.UpdateMask) error
+	DeleteById(ctx context.Context, id uuid.UUID, version int64) error
 	Create(ctx context.Context, g *models.Result) error
+	FetchByGameId(ctx context.Context, gameId uuid.UUID) (models.Result, error)
+	List(ctx context.Context, filter models.ResultsFilter) (models.ResultsPage, error)
+	Review(ctx context.Context, gameId uuid.UUID) (models.ResultReview, error)
+	// Report records a participant's version of how a game ended.
+	Report(ctx context.Context, report *models.ResultReport) (models.ResultReview, error)
+	Comment(ctx context.Context, c *models.DisputeComment) error
+	// Resolve settles a review with r, or with the report acceptReport names.
+	Resolve(ctx context.Context, r *models.Result, acceptReport uuid.NullUUID, resolution string) (models.ResultReview, error)
 }
@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+	"tournaments-core/internal/domain/models"
+)
+
+type StandingsUseCase interface {
+	// Standings computes the table of a tournament from its results and
+	// returns one page of it.
+	Standings(ctx context.Context, q models.StandingsQuery) (models.StandingsPage, error)
+}
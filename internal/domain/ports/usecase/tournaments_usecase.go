@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type TournamentsUseCase interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.Tournament, error)
+	Update(ctx context.Context, updated *models.Tournament) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, t *models.Tournament) error
+	GenerateBracket(ctx context.Context, id uuid.UUID, seeds []uuid.UUID) ([]models.Game, error)
+	SwissStandings(ctx context.Context, id uuid.UUID) ([]models.SwissStanding, error)
+	Grants(ctx context.Context, id uuid.UUID) ([]models.TournamentGrant, error)
+	Grant(ctx context.Context, g *models.TournamentGrant) error
+	Revoke(ctx context.Context, id uuid.UUID, subject string) error
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+type WebhooksUseCase interface {
+	FetchById(ctx context.Context, id uuid.UUID) (models.Webhook, error)
+	FetchAll(ctx context.Context) ([]models.Webhook, error)
+	// Update overwrites the webhook, an empty secret keeps the current one.
+	Update(ctx context.Context, updated *models.Webhook) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	// Create generates a secret when none is given.
+	Create(ctx context.Context, w *models.Webhook) error
+	ListDeliveries(ctx context.Context, filter models.DeliveriesFilter) (models.DeliveriesPage, error)
+	Redeliver(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error)
+}
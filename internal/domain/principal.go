@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"tournaments-core/internal/domain/models"
+)
+
+type principalKey struct{}
+
+// WithPrincipal attaches the authenticated caller to the request context.
+func WithPrincipal(ctx context.Context, p models.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFrom returns the caller the request was authenticated for. There
+// is none for work the service starts itself.
+func PrincipalFrom(ctx context.Context) (models.Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(models.Principal)
+	return p, ok
+}
@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+// Sink receives relayed events. An event is delivered again when any sink
+// fails it, so sinks must tolerate duplicates; the sequence identifies an
+// event across deliveries. Consumers inside the service implement Sink
+// directly, like the webhooks dispatcher, or read a ChannelSink.
+type Sink interface {
+	Deliver(ctx context.Context, e models.Event) error
+}
+
+const (
+	batchSize    = 100
+	pollInterval = time.Second
+	// claimLease hides claimed events from other relays. It is renewed for
+	// the rest of the batch once half of it has passed, so it only has to
+	// outlast delivering one event, otherwise another relay claims the same
+	// events while they are being delivered.
+	claimLease = time.Minute
+	// deliverTimeout bounds delivering one event to every sink, it stays well
+	// under half of the lease.
+	deliverTimeout = 20 * time.Second
+	maxBackoff     = 5 * time.Minute
+)
+
+// Relay moves events from the outbox to the sinks. Events are marked
+// delivered only after every sink took them, so an event written with a
+// change reaches each sink at least once, even across restarts. Events are
+// delivered in outbox order, except that a failed event is retried later
+// while the following ones move on.
+type Relay struct {
+	outbox repository.OutboxRepository
+	sinks  []Sink
+}
+
+func NewRelay(outbox repository.OutboxRepository, sinks ...Sink) *Relay {
+	return &Relay{outbox: outbox, sinks: sinks}
+}
+
+// Run relays events until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	for {
+		n, err := r.relayBatch(ctx)
+		if err != nil {
+			log.Printf("[OUTBOX]: %v", err)
+		}
+
+		// A full batch means more events are likely waiting.
+		if err == nil && n == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	claimed := time.Now()
+	events, err := r.outbox.Claim(ctx, batchSize, claimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, e := range events {
+		if time.Since(claimed) > claimLease/2 {
+			if err := r.outbox.Extend(ctx, sequences(events[i:]), claimLease); err != nil {
+				return 0, err
+			}
+			claimed = time.Now()
+		}
+
+		if err := r.deliver(ctx, e.Event); err != nil {
+			log.Printf("[OUTBOX]: Failed to deliver event %d: %v", e.Sequence, err)
+			if err := r.outbox.Retry(ctx, e.Sequence, backoff(e.Attempts), err.Error()); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if err := r.outbox.MarkDelivered(ctx, e.Sequence); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(events), nil
+}
+
+func (r *Relay) deliver(ctx context.Context, e models.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, deliverTimeout)
+	defer cancel()
+
+	for _, s := range r.sinks {
+		if err := s.Deliver(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backoff doubles the delay with every failed attempt.
+func backoff(attempts int) time.Duration {
+	if attempts >= 9 {
+		return maxBackoff
+	}
+	return min(time.Second<<attempts, maxBackoff)
+}
+
+func sequences(events []models.OutboxEvent) []uint64 {
+	seqs := make([]uint64, 0, len(events))
+	for _, e := range events {
+		seqs = append(seqs, e.Sequence)
+	}
+	return seqs
+}
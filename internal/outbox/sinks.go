@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+	"tournaments-core/internal/domain/models"
+)
+
+// LogSink writes a line per event.
+type LogSink struct{}
+
+func (LogSink) Deliver(_ context.Context, e models.Event) error {
+	log.Printf("[OUTBOX]: #%d %s %s %s", e.Sequence, e.Entity, e.EntityID, e.Type)
+	return nil
+}
+
+const webhookTimeout = 10 * time.Second
+
+// WebhookSink posts every event as JSON to a URL. Any response other than
+// 2xx fails the delivery.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{url: url, client: &http.Client{Timeout: webhookTimeout}}
+}
+
+func (s *WebhookSink) Deliver(ctx context.Context, e models.Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("webhook: failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Sequence", strconv.FormatUint(e.Sequence, 10))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: %s responded %s", s.url, resp.Status)
+	}
+
+	return nil
+}
+
+// ChannelSink hands events to a consumer in the same process. Delivery waits
+// for room in the channel, so a slow consumer holds the relay back instead of
+// losing events. An event counts as delivered once it is in the channel.
+type ChannelSink struct {
+	events chan models.Event
+}
+
+func NewChannelSink(buffer int) *ChannelSink {
+	return &ChannelSink{events: make(chan models.Event, buffer)}
+}
+
+func (s *ChannelSink) Events() <-chan models.Event {
+	return s.events
+}
+
+func (s *ChannelSink) Deliver(ctx context.Context, e models.Event) error {
+	select {
+	case s.events <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
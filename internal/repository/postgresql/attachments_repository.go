@@ -0,0 +1,152 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+type attachmentsRepository struct {
+	db *sql.DB
+}
+
+func NewAttachmentsRepository(connect string) (repository.AttachmentsRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &attachmentsRepository{db}, nil
+}
+
+const attachmentColumns = `attachment_id, result_id, report_id, kind, name, content_type, size_bytes, sha256, url, storage_key, uploaded_by, created_at`
+
+func scanAttachment(row interface{ Scan(dest ...any) error }) (models.Attachment, error) {
+	var a models.Attachment
+	err := row.Scan(
+		&a.AttachmentID,
+		&a.ResultID,
+		&a.ReportID,
+		&a.Kind,
+		&a.Name,
+		&a.ContentType,
+		&a.Size,
+		&a.SHA256,
+		&a.URL,
+		&a.StorageKey,
+		&a.UploadedBy,
+		&a.CreatedAt,
+	)
+	return a, err
+}
+
+func (r *attachmentsRepository) FetchById(ctx context.Context, id uuid.UUID) (models.Attachment, error) {
+	const op = "postgresql.AttachmentsRepository.FetchById"
+
+	query := `
+	SELECT ` + attachmentColumns + `
+	FROM game_creator.result_attachments WHERE attachment_id = $1
+	`
+
+	a, err := scanAttachment(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Attachment{}, fmt.Errorf("%s: %w", op, domain.NotFound("attachment", id))
+		}
+		return models.Attachment{}, fmt.Errorf("%s: Failed to get attachment from db: %w", op, dbError(err))
+	}
+
+	return a, nil
+}
+
+func (r *attachmentsRepository) FetchByResult(ctx context.Context, resultId uuid.UUID) ([]models.Attachment, error) {
+	const op = "postgresql.AttachmentsRepository.FetchByResult"
+
+	query := `
+	SELECT ` + attachmentColumns + `
+	FROM game_creator.result_attachments WHERE result_id = $1
+	ORDER BY created_at, attachment_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, resultId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get attachments from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var attachments []models.Attachment
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan attachment: %w", op, dbError(err))
+		}
+		attachments = append(attachments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: Failed to get attachments from db: %w", op, dbError(err))
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentsRepository) Create(ctx context.Context, a *models.Attachment) error {
+	const op = "postgresql.AttachmentsRepository.Create"
+
+	query := `
+	INSERT INTO game_creator.result_attachments (attachment_id, result_id, report_id, kind, name, content_type, size_bytes, sha256, url, storage_key, uploaded_by)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	RETURNING created_at
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		a.AttachmentID,
+		a.ResultID,
+		a.ReportID,
+		a.Kind,
+		a.Name,
+		a.ContentType,
+		a.Size,
+		a.SHA256,
+		a.URL,
+		a.StorageKey,
+		a.UploadedBy,
+	).Scan(&a.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to insert into result_attachments: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *attachmentsRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	const op = "postgresql.AttachmentsRepository.DeleteById"
+
+	query := `
+	DELETE FROM game_creator.result_attachments WHERE attachment_id = $1
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to delete from result_attachments: %w", op, dbError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, domain.NotFound("attachment", id))
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package postgresql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain"
+)
+
+var errMalformedToken = domain.InvalidArgument("Malformed page token")
+
+// cursor is the keyset position a page ends at. It is handed to clients as an
+// opaque token and only makes sense together with the sort it was built for.
+type cursor struct {
+	Sort string    `json:"s"`
+	Key  string    `json:"k"`
+	ID   uuid.UUID `json:"id"`
+}
+
+func encodeCursor(c cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(token, sort string) (cursor, error) {
+	var c cursor
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errMalformedToken
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, errMalformedToken
+	}
+	if c.Sort != sort {
+		return c, domain.InvalidArgument("Page token was issued for a different sort order")
+	}
+	return c, nil
+}
+
+// placeholders collects query arguments and hands out their $n markers.
+type placeholders struct {
+	args []any
+}
+
+func (p *placeholders) add(v any) string {
+	p.args = append(p.args, v)
+	return fmt.Sprintf("$%d", len(p.args))
+}
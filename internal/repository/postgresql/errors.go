@@ -0,0 +1,70 @@
+package postgresql
+
+import (
+	"database/sql/driver"
+	"errors"
+	"github.com/lib/pq"
+	"net"
+	"strings"
+	"tournaments-core/internal/domain"
+)
+
+// dbError translates driver errors into domain errors so that callers can
+// tell bad requests and outages from bugs. Other errors are returned as is.
+func dbError(err error) error {
+	var domainErr *domain.Error
+	if err == nil || errors.As(err, &domainErr) {
+		return err
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch {
+		case pqErr.Code == "23505": // unique_violation
+			return &domain.Error{
+				Kind:     domain.ErrAlreadyExists,
+				Reason:   "ALREADY_EXISTS",
+				Resource: pqErr.Table,
+				Message:  "already exists: " + pqErr.Detail,
+				Err:      err,
+			}
+		case pqErr.Code == "23503": // foreign_key_violation
+			if strings.HasPrefix(pqErr.Message, "update or delete") {
+				return &domain.Error{
+					Kind:     domain.ErrConflict,
+					Reason:   "STILL_REFERENCED",
+					Resource: pqErr.Table,
+					Message:  "still referenced: " + pqErr.Detail,
+					Err:      err,
+				}
+			}
+			return &domain.Error{
+				Kind:     domain.ErrInvalidArgument,
+				Reason:   "UNKNOWN_REFERENCE",
+				Resource: pqErr.Table,
+				Message:  "unknown reference: " + pqErr.Detail,
+				Err:      err,
+			}
+		case pqErr.Code.Class() == "23", pqErr.Code.Class() == "22": // integrity constraint violation, data exception
+			return &domain.Error{
+				Kind:     domain.ErrInvalidArgument,
+				Reason:   "INVALID_ARGUMENT",
+				Resource: pqErr.Table,
+				Message:  pqErr.Message,
+				Err:      err,
+			}
+		case pqErr.Code == "40001", pqErr.Code == "40P01": // serialization_failure, deadlock_detected
+			return &domain.Error{Kind: domain.ErrConflict, Reason: "CONCURRENT_UPDATE", Message: pqErr.Message, Err: err}
+		case pqErr.Code.Class() == "08", pqErr.Code.Class() == "53", pqErr.Code.Class() == "57": // connection, resources, operator intervention
+			return &domain.Error{Kind: domain.ErrUnavailable, Reason: "DATABASE_UNAVAILABLE", Message: "database is unavailable", Err: err}
+		}
+		return err
+	}
+
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.As(err, &netErr) {
+		return &domain.Error{Kind: domain.ErrUnavailable, Reason: "DATABASE_UNAVAILABLE", Message: "database is unavailable", Err: err}
+	}
+
+	return err
+}
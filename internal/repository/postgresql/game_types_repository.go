@@ -0,0 +1,160 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+type gameTypesRepository struct {
+	db *sql.DB
+}
+
+func NewGameTypesRepository(connect string) (repository.GameTypesRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &gameTypesRepository{db}, nil
+}
+
+func (r *gameTypesRepository) Create(ctx context.Context, gt *models.GameType) error {
+	const op = "postgresql.GameTypesRepository.Create"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	INSERT INTO game_creator.game_types (game_type_id, platform_name)
+	VALUES ($1, $2)
+	`
+
+	_, err = tx.ExecContext(ctx, query, gt.GameTypeID, gt.PlatformName)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to insert into game_types: %w", op, dbError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *gameTypesRepository) FetchById(ctx context.Context, id uuid.UUID) (models.GameType, error) {
+	const op = "postgresql.GameTypesRepository.FetchById"
+
+	query := `
+	SELECT game_type_id, platform_name
+	FROM game_creator.game_types WHERE game_type_id = $1
+	`
+
+	row := conn(ctx, r.db).QueryRowContext(ctx, query, id)
+
+	var gameType models.GameType
+	err := row.Scan(&gameType.GameTypeID, &gameType.PlatformName)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.GameType{}, fmt.Errorf("%s: %w", op, domain.NotFound("game type", id))
+		}
+		return models.GameType{}, fmt.Errorf("%s: Failed to get game type from db: %w", op, dbError(err))
+	}
+
+	return gameType, nil
+}
+
+func (r *gameTypesRepository) FetchAll(ctx context.Context) ([]models.GameType, error) {
+	const op = "postgresql.GameTypesRepository.FetchAll"
+
+	query := `
+	SELECT game_type_id, platform_name
+	FROM game_creator.game_types
+	ORDER BY platform_name, game_type_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get game types from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var gameTypes []models.GameType
+	for rows.Next() {
+		var gameType models.GameType
+		if err := rows.Scan(&gameType.GameTypeID, &gameType.PlatformName); err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan game type: %w", op, dbError(err))
+		}
+		gameTypes = append(gameTypes, gameType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: Failed to get game types from db: %w", op, dbError(err))
+	}
+
+	return gameTypes, nil
+}
+
+func (r *gameTypesRepository) Update(ctx context.Context, updated *models.GameType) error {
+	const op = "postgresql.GameTypesRepository.Update"
+
+	query := `
+	UPDATE game_creator.game_types
+	SET platform_name = $1
+	WHERE game_type_id = $2
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, updated.PlatformName, updated.GameTypeID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to update game type: %w", op, dbError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, domain.NotFound("game type", updated.GameTypeID))
+	}
+
+	return nil
+}
+
+func (r *gameTypesRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	const op = "postgresql.GameTypesRepository.DeleteById"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	DELETE FROM game_creator.game_types WHERE game_type_id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, query, id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to delete from game_types: %w", op, dbError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
@@ -3,9 +3,14 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"strconv"
+	"strings"
+	"time"
+	"tournaments-core/internal/domain"
 	"tournaments-core/internal/domain/models"
 	"tournaments-core/internal/domain/ports/repository"
 )
@@ -31,110 +36,522 @@ func NewGamesRepository(connect string) (repository.GamesRepository, error) {
 func (r *gamesRepository) Create(ctx context.Context, g *models.Game) error {
 	const op = "postgresql.GamesRepository.Create"
 
-	tx, err := r.db.Begin()
+	tx, err := begin(ctx, r.db)
 	if err != nil {
-		return fmt.Errorf("%s: Failed to begin transaction: %w", op, err)
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
 	}
 
-	query := `
-	INSERT INTO game_creator.games (game_id, game_start, game_type_id)
-	VALUES ($1, $2, $3)
-	`
+	if err := insertGame(ctx, tx, g); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, dbError(err))
+	}
 
-	_, err = tx.ExecContext(ctx, query, g.GameID.String(), g.GameStart, g.GameTypeID.String())
-	if err != nil {
+	if err := recordGame(ctx, tx, models.EventCreated, g.GameID); err != nil {
 		tx.Rollback()
-		return fmt.Errorf("%s: Failed to insert into games: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("%s: Failed to commit transaction: %w", op, err)
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
 	}
 
 	return nil
 }
 
-func (r *gamesRepository) FetchById(ctx context.Context, id uuid.UUID) (models.Game, error) {
-	const op = "postgresql.GamesRepository.FetchById"
+func (r *gamesRepository) CreateMany(ctx context.Context, games []models.Game) error {
+	const op = "postgresql.GamesRepository.CreateMany"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	for i := range games {
+		if err := insertGame(ctx, tx, &games[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: %w", op, dbError(err))
+		}
+	}
+
+	// Events are written once every game exists, so that they carry the
+	// complete games.
+	for _, g := range games {
+		if err := recordGame(ctx, tx, models.EventCreated, g.GameID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
 
+func insertGame(ctx context.Context, tx executor, g *models.Game) error {
 	query := `
-	SELECT game_id, game_start, game_type_id
-	FROM game_creator.games WHERE game_id = $1
+	INSERT INTO game_creator.games (game_id, game_start, game_type_id, tournament_id, bracket, group_number, round, position,
+	                                winner_next_game_id, winner_next_slot, loser_next_game_id, loser_next_slot, status)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 	`
 
-	row := r.db.QueryRowContext(ctx, query, id)
+	status := g.Status
+	if status == "" {
+		status = models.GameScheduled
+	}
+
+	_, err := tx.ExecContext(ctx, query,
+		g.GameID.String(),
+		g.GameStart,
+		g.GameTypeID.String(),
+		g.TournamentID,
+		g.Bracket,
+		g.Group,
+		g.Round,
+		g.Position,
+		g.WinnerNext.GameID,
+		g.WinnerNext.Slot,
+		g.LoserNext.GameID,
+		g.LoserNext.Slot,
+		status,
+	)
+	if err != nil {
+		return fmt.Errorf("Failed to insert into games: %w", dbError(err))
+	}
+
+	participantsQuery := `
+	INSERT INTO game_creator.game_participants (game_id, slot, participant_id)
+	VALUES ($1, $2, $3)
+	`
+
+	for _, p := range g.Participants {
+		_, err = tx.ExecContext(ctx, participantsQuery, g.GameID, p.Slot, p.ParticipantID)
+		if err != nil {
+			return fmt.Errorf("Failed to insert into game_participants: %w", dbError(err))
+		}
+	}
 
+	return nil
+}
+
+const gameColumns = `g.game_id, g.game_start, g.game_type_id, COALESCE(gt.platform_name, ''), g.status, g.tournament_id,
+	       g.bracket, g.group_number, g.round, g.position,
+	       g.winner_next_game_id, g.winner_next_slot, g.loser_next_game_id, g.loser_next_slot, g.version`
+
+// gamesFrom joins the platform name of the game type into game reads.
+const gamesFrom = `game_creator.games g
+	LEFT JOIN game_creator.game_types gt ON gt.game_type_id = g.game_type_id`
+
+func scanGame(row interface{ Scan(dest ...any) error }) (models.Game, error) {
 	var game models.Game
-	err := row.Scan(&game.GameID, &game.GameStart, &game.GameTypeID)
+	err := row.Scan(
+		&game.GameID,
+		&game.GameStart,
+		&game.GameTypeID,
+		&game.PlatformName,
+		&game.Status,
+		&game.TournamentID,
+		&game.Bracket,
+		&game.Group,
+		&game.Round,
+		&game.Position,
+		&game.WinnerNext.GameID,
+		&game.WinnerNext.Slot,
+		&game.LoserNext.GameID,
+		&game.LoserNext.Slot,
+		&game.Version,
+	)
+	return game, err
+}
+
+func (r *gamesRepository) FetchById(ctx context.Context, id uuid.UUID) (models.Game, error) {
+	const op = "postgresql.GamesRepository.FetchById"
+
+	game, err := fetchGame(ctx, conn(ctx, r.db), id)
+	if err != nil {
+		return models.Game{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return game, nil
+}
+
+// fetchGame reads a game with its participants, inside a transaction when q
+// is one.
+func fetchGame(ctx context.Context, q queryer, id uuid.UUID) (models.Game, error) {
+	query := `
+	SELECT ` + gameColumns + `
+	FROM ` + gamesFrom + `
+	WHERE g.game_id = $1
+	`
 
+	game, err := scanGame(q.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.Game{}, fmt.Errorf("%s: Game not found", op)
+			return models.Game{}, domain.NotFound("game", id)
 		}
-		return models.Game{}, fmt.Errorf("%s: Failed to get game from db: %w", op, err)
+		return models.Game{}, fmt.Errorf("Failed to get game from db: %w", dbError(err))
+	}
+
+	participants, err := fetchParticipants(ctx, q, `WHERE gp.game_id = $1`, id)
+	if err != nil {
+		return models.Game{}, err
 	}
+	game.Participants = participants[game.GameID]
 
 	return game, nil
 }
 
+func (r *gamesRepository) FetchByTournament(ctx context.Context, tournamentId uuid.UUID) ([]models.Game, error) {
+	const op = "postgresql.GamesRepository.FetchByTournament"
+
+	query := `
+	SELECT ` + gameColumns + `
+	FROM ` + gamesFrom + `
+	WHERE g.tournament_id = $1
+	ORDER BY g.bracket DESC, g.group_number, g.round, g.position
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tournamentId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get games from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var games []models.Game
+	for rows.Next() {
+		game, err := scanGame(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan game: %w", op, dbError(err))
+		}
+		games = append(games, game)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: Failed to get games from db: %w", op, dbError(err))
+	}
+
+	participants, err := fetchParticipants(ctx, conn(ctx, r.db),
+		`JOIN game_creator.games g ON g.game_id = gp.game_id WHERE g.tournament_id = $1`, tournamentId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+	for i := range games {
+		games[i].Participants = participants[games[i].GameID]
+	}
+
+	return games, nil
+}
+
+var gameSortColumns = map[models.GameSort]string{
+	models.GameSortStart: "g.game_start",
+	models.GameSortRound: "g.round",
+}
+
+// gameCursorKey returns the sort key of a game as stored in a page token.
+func gameCursorKey(g models.Game, sort models.GameSort) string {
+	if sort == models.GameSortRound {
+		return strconv.Itoa(g.Round)
+	}
+	return g.GameStart.Format(time.RFC3339Nano)
+}
+
+// parseGameCursorKey turns a page token key back into a typed query argument.
+func parseGameCursorKey(key string, sort models.GameSort) (any, error) {
+	if sort == models.GameSortRound {
+		return strconv.Atoi(key)
+	}
+	return time.Parse(time.RFC3339Nano, key)
+}
+
+func (r *gamesRepository) List(ctx context.Context, f models.GamesFilter) (models.GamesPage, error) {
+	const op = "postgresql.GamesRepository.List"
+
+	if f.Sort == "" {
+		f.Sort = models.GameSortStart
+	}
+	column, ok := gameSortColumns[f.Sort]
+	if !ok {
+		return models.GamesPage{}, fmt.Errorf("%s: %w", op, domain.InvalidArgument("Unknown sort %q", f.Sort))
+	}
+
+	var p placeholders
+	var conds []string
+	if !f.StartFrom.IsZero() {
+		conds = append(conds, "g.game_start >= "+p.add(f.StartFrom))
+	}
+	if !f.StartTo.IsZero() {
+		conds = append(conds, "g.game_start < "+p.add(f.StartTo))
+	}
+	if f.GameTypeID.Valid {
+		conds = append(conds, "g.game_type_id = "+p.add(f.GameTypeID.UUID))
+	}
+	if f.TournamentID.Valid {
+		conds = append(conds, "g.tournament_id = "+p.add(f.TournamentID.UUID))
+	}
+	if f.Status != "" {
+		conds = append(conds, "g.status = "+p.add(f.Status))
+	}
+
+	direction, compare := "ASC", ">"
+	if f.Descending {
+		direction, compare = "DESC", "<"
+	}
+	if f.Page.Token != "" {
+		c, err := decodeCursor(f.Page.Token, string(f.Sort))
+		if err != nil {
+			return models.GamesPage{}, fmt.Errorf("%s: %w", op, dbError(err))
+		}
+		key, err := parseGameCursorKey(c.Key, f.Sort)
+		if err != nil {
+			return models.GamesPage{}, fmt.Errorf("%s: %w", op, errMalformedToken)
+		}
+		conds = append(conds, fmt.Sprintf("(%s, g.game_id) %s (%s, %s)",
+			column, compare, p.add(key), p.add(c.ID)))
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	limit := f.Page.Limit()
+	query := `
+	SELECT ` + gameColumns + `
+	FROM ` + gamesFrom + `
+	` + where + `
+	ORDER BY ` + column + ` ` + direction + `, g.game_id ` + direction + `
+	LIMIT ` + p.add(limit+1)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, p.args...)
+	if err != nil {
+		return models.GamesPage{}, fmt.Errorf("%s: Failed to get games from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var page models.GamesPage
+	for rows.Next() {
+		game, err := scanGame(rows)
+		if err != nil {
+			return models.GamesPage{}, fmt.Errorf("%s: Failed to scan game: %w", op, dbError(err))
+		}
+		page.Games = append(page.Games, game)
+	}
+	if err := rows.Err(); err != nil {
+		return models.GamesPage{}, fmt.Errorf("%s: Failed to get games from db: %w", op, dbError(err))
+	}
+
+	if len(page.Games) > limit {
+		page.Games = page.Games[:limit]
+		last := page.Games[limit-1]
+		page.NextPageToken = encodeCursor(cursor{Sort: string(f.Sort), Key: gameCursorKey(last, f.Sort), ID: last.GameID})
+	}
+
+	if len(page.Games) == 0 {
+		return page, nil
+	}
+	ids := make([]string, 0, len(page.Games))
+	for _, g := range page.Games {
+		ids = append(ids, g.GameID.String())
+	}
+	participants, err := fetchParticipants(ctx, conn(ctx, r.db), `WHERE gp.game_id = ANY($1::uuid[])`, pq.StringArray(ids))
+	if err != nil {
+		return models.GamesPage{}, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+	for i := range page.Games {
+		page.Games[i].Participants = participants[page.Games[i].GameID]
+	}
+
+	return page, nil
+}
+
+// fetchParticipants loads game slots grouped by game, the filter is appended
+// to the select from game_participants aliased as gp.
+func fetchParticipants(ctx context.Context, q queryer, filter string, args ...any) (map[uuid.UUID][]models.GameParticipant, error) {
+	query := `
+	SELECT gp.game_id, gp.slot, gp.participant_id
+	FROM game_creator.game_participants gp ` + filter + `
+	ORDER BY gp.game_id, gp.slot
+	`
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get game participants from db: %w", dbError(err))
+	}
+	defer rows.Close()
+
+	participants := make(map[uuid.UUID][]models.GameParticipant)
+	for rows.Next() {
+		var gameId uuid.UUID
+		var p models.GameParticipant
+		if err := rows.Scan(&gameId, &p.Slot, &p.ParticipantID); err != nil {
+			return nil, fmt.Errorf("Failed to scan game participant: %w", dbError(err))
+		}
+		participants[gameId] = append(participants[gameId], p)
+	}
+
+	return participants, rows.Err()
+}
+
+func (r *gamesRepository) SetParticipant(ctx context.Context, gameId uuid.UUID, p models.GameParticipant) error {
+	const op = "postgresql.GamesRepository.SetParticipant"
+
+	query := `
+	INSERT INTO game_creator.game_participants (game_id, slot, participant_id)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (game_id, slot) DO UPDATE SET participant_id = EXCLUDED.participant_id
+	`
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	_, err = tx.ExecContext(ctx, query, gameId, p.Slot, p.ParticipantID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to set game participant: %w", op, dbError(err))
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE game_creator.games SET version = version + 1 WHERE game_id = $1`, gameId)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to update game version: %w", op, dbError(err))
+	}
+
+	if err := recordGame(ctx, tx, models.EventUpdated, gameId); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+// Update overwrites the game and replaces its participants when Participants
+// is not nil.
 func (r *gamesRepository) Update(ctx context.Context, updated *models.Game) error {
 	const op = "postgresql.GamesRepository.Update"
 
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
 	query := `
 	UPDATE game_creator.games
-	SET 
-	    game_start=COALESCE($1, game_start),
-	    game_type_id=COALESCE($2, game_type_id)
-	WHERE game_id=$3
+	SET game_start = $1,
+	    game_type_id = $2,
+	    tournament_id = $3,
+	    round = $4,
+	    status = $7,
+	    version = version + 1
+	WHERE game_id = $5 AND ($6::bigint = 0 OR version = $6)
+	RETURNING version
 	`
 
-	var nullTime sql.NullTime
-	if !updated.GameStart.IsZero() {
-		nullTime = sql.NullTime{Time: updated.GameStart, Valid: true}
-	}
-
-	result, err := r.db.ExecContext(ctx, query,
-		nullTime,
+	err = tx.QueryRowContext(ctx, query,
+		updated.GameStart,
 		updated.GameTypeID,
+		updated.TournamentID,
+		updated.Round,
 		updated.GameID,
-	)
+		updated.Version,
+		updated.Status,
+	).Scan(&updated.Version)
 
+	if err == sql.ErrNoRows {
+		err = versionConflict(ctx, tx, "game_creator.games", "game_id", "game", updated.GameID)
+		tx.Rollback()
+		return fmt.Errorf("%s: game with id %s: %w", op, updated.GameID, err)
+	}
 	if err != nil {
-		return fmt.Errorf("%s: failed to update game: %w", op, err)
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to update game: %w", op, dbError(err))
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("%s: failed to get rows affected: %w", op, err)
+	if updated.Participants != nil {
+		_, err = tx.ExecContext(ctx, `DELETE FROM game_creator.game_participants WHERE game_id = $1`, updated.GameID)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: Failed to delete from game_participants: %w", op, dbError(err))
+		}
+
+		participantsQuery := `
+		INSERT INTO game_creator.game_participants (game_id, slot, participant_id)
+		VALUES ($1, $2, $3)
+		`
+
+		for _, p := range updated.Participants {
+			_, err = tx.ExecContext(ctx, participantsQuery, updated.GameID, p.Slot, p.ParticipantID)
+			if err != nil {
+				tx.Rollback()
+				return fmt.Errorf("%s: Failed to insert into game_participants: %w", op, dbError(err))
+			}
+		}
+	}
+
+	if err := recordGame(ctx, tx, models.EventUpdated, updated.GameID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("%s: game with id %s not found", op, updated.GameID)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
 	}
 
 	return nil
 }
 
-func (r *gamesRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+// DeleteById removes the game, a non-zero version must match the stored one.
+func (r *gamesRepository) DeleteById(ctx context.Context, id uuid.UUID, version int64) error {
 	const op = "postgresql.GamesRepository.DeleteById"
 
-	tx, err := r.db.Begin()
+	tx, err := begin(ctx, r.db)
 	if err != nil {
-		return fmt.Errorf("%s: Failed to begin transaction: %w", op, err)
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	// The deletion event carries the last state of the game.
+	last, err := fetchGame(ctx, tx, id)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	query := `
-	DELETE FROM game_creator.games WHERE game_id = $1
+	DELETE FROM game_creator.games WHERE game_id = $1 AND ($2::bigint = 0 OR version = $2)
 	`
 
-	_, err = tx.ExecContext(ctx, query, id)
+	result, err := tx.ExecContext(ctx, query, id, version)
 	if err != nil {
 		tx.Rollback()
-		return fmt.Errorf("%s: Failed to delete from games: %w", op, err)
+		return fmt.Errorf("%s: Failed to delete from games: %w", op, dbError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 && version != 0 {
+		err = versionConflict(ctx, tx, "game_creator.games", "game_id", "game", id)
+		tx.Rollback()
+		return fmt.Errorf("%s: game with id %s: %w", op, id, err)
+	}
+
+	if rowsAffected > 0 {
+		if err := writeOutbox(ctx, tx, models.GameEvent(models.EventDeleted, last)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("%s: Failed to commit transaction: %w", op, err)
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
 	}
 
 	return nil
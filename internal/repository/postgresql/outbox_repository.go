@@ -0,0 +1,250 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sort"
+	"time"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+// writeOutbox stores the event in the transaction of the change it describes,
+// so that the event exists exactly when the change was committed.
+func writeOutbox(ctx context.Context, tx executor, e models.Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("Failed to encode event: %w", err)
+	}
+
+	query := `
+	INSERT INTO game_creator.outbox (entity, entity_id, event_type, payload)
+	VALUES ($1, $2, $3, $4)
+	`
+
+	if _, err := tx.ExecContext(ctx, query, e.Entity, e.EntityID, e.Type, string(payload)); err != nil {
+		return fmt.Errorf("Failed to insert into outbox: %w", dbError(err))
+	}
+
+	return nil
+}
+
+// recordGame writes an event carrying the game as the transaction sees it.
+func recordGame(ctx context.Context, tx executor, t models.EventType, id uuid.UUID) error {
+	g, err := fetchGame(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	return writeOutbox(ctx, tx, models.GameEvent(t, g))
+}
+
+// recordResult writes an event carrying the result as the transaction sees
+// it.
+func recordResult(ctx context.Context, tx executor, t models.EventType, id uuid.UUID) error {
+	r, err := fetchResult(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	return recordResultState(ctx, tx, t, r)
+}
+
+func recordResultState(ctx context.Context, tx executor, t models.EventType, r models.Result) error {
+	var tournamentId uuid.NullUUID
+	query := `SELECT tournament_id FROM game_creator.games WHERE game_id = $1`
+	err := tx.QueryRowContext(ctx, query, r.GameID).Scan(&tournamentId)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("Failed to get tournament of result: %w", dbError(err))
+	}
+	return writeOutbox(ctx, tx, models.ResultEvent(t, r, tournamentId))
+}
+
+// recordTournament writes an event carrying the tournament as the
+// transaction sees it.
+func recordTournament(ctx context.Context, tx executor, t models.EventType, id uuid.UUID) error {
+	tournament, err := fetchTournament(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	return writeOutbox(ctx, tx, models.TournamentEvent(t, tournament))
+}
+
+type outboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(connect string) (repository.OutboxRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &outboxRepository{db}, nil
+}
+
+func (r *outboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]models.OutboxEvent, error) {
+	const op = "postgresql.OutboxRepository.Claim"
+
+	// SKIP LOCKED lets several relays claim disjoint events at once.
+	query := `
+	UPDATE game_creator.outbox o
+	SET available_at = now() + $2 * interval '1 millisecond'
+	FROM (
+	    SELECT event_id
+	    FROM game_creator.outbox
+	    WHERE delivered_at IS NULL AND available_at <= now()
+	    ORDER BY event_id
+	    LIMIT $1
+	    FOR UPDATE SKIP LOCKED
+	) claimed
+	WHERE o.event_id = claimed.event_id
+	RETURNING o.event_id, o.payload, o.created_at, o.attempts
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to claim outbox events: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var events []models.OutboxEvent
+	for rows.Next() {
+		var e models.OutboxEvent
+		var payload []byte
+		var createdAt time.Time
+		if err := rows.Scan(&e.Sequence, &payload, &createdAt, &e.Attempts); err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan outbox event: %w", op, dbError(err))
+		}
+
+		sequence := e.Sequence
+		if err := json.Unmarshal(payload, &e.Event); err != nil {
+			return nil, fmt.Errorf("%s: Failed to decode outbox event %d: %w", op, sequence, err)
+		}
+		e.Sequence = sequence
+		e.At = createdAt
+
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	// RETURNING does not keep the order of the subquery.
+	sort.Slice(events, func(i, j int) bool { return events[i].Sequence < events[j].Sequence })
+
+	return events, nil
+}
+
+func (r *outboxRepository) Extend(ctx context.Context, sequences []uint64, lease time.Duration) error {
+	const op = "postgresql.OutboxRepository.Extend"
+
+	query := `
+	UPDATE game_creator.outbox
+	SET available_at = now() + $2 * interval '1 millisecond'
+	WHERE event_id = ANY($1) AND delivered_at IS NULL
+	`
+
+	ids := make([]int64, 0, len(sequences))
+	for _, s := range sequences {
+		ids = append(ids, int64(s))
+	}
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, pq.Array(ids), lease.Milliseconds()); err != nil {
+		return fmt.Errorf("%s: Failed to update outbox: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) MarkDelivered(ctx context.Context, sequence uint64) error {
+	const op = "postgresql.OutboxRepository.MarkDelivered"
+
+	query := `
+	UPDATE game_creator.outbox SET delivered_at = now(), last_error = NULL WHERE event_id = $1
+	`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, sequence); err != nil {
+		return fmt.Errorf("%s: Failed to update outbox: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) Retry(ctx context.Context, sequence uint64, delay time.Duration, cause string) error {
+	const op = "postgresql.OutboxRepository.Retry"
+
+	query := `
+	UPDATE game_creator.outbox
+	SET attempts = attempts + 1,
+	    last_error = $2,
+	    available_at = now() + $3 * interval '1 millisecond'
+	WHERE event_id = $1
+	`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, sequence, cause, delay.Milliseconds()); err != nil {
+		return fmt.Errorf("%s: Failed to update outbox: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) Since(ctx context.Context, after uint64, limit int) ([]models.Event, error) {
+	const op = "postgresql.OutboxRepository.Since"
+
+	query := `
+	SELECT event_id, payload, created_at
+	FROM game_creator.outbox
+	WHERE event_id > $1
+	ORDER BY event_id
+	LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get outbox events: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var events []models.Event
+	for rows.Next() {
+		var e models.Event
+		var sequence uint64
+		var payload []byte
+		var createdAt time.Time
+		if err := rows.Scan(&sequence, &payload, &createdAt); err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan outbox event: %w", op, dbError(err))
+		}
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return nil, fmt.Errorf("%s: Failed to decode outbox event %d: %w", op, sequence, err)
+		}
+		e.Sequence = sequence
+		e.At = createdAt
+
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	return events, nil
+}
+
+func (r *outboxRepository) Last(ctx context.Context) (uint64, error) {
+	const op = "postgresql.OutboxRepository.Last"
+
+	var last uint64
+	query := `SELECT COALESCE(MAX(event_id), 0) FROM game_creator.outbox`
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query).Scan(&last); err != nil {
+		return 0, fmt.Errorf("%s: Failed to get last outbox event: %w", op, dbError(err))
+	}
+
+	return last, nil
+}
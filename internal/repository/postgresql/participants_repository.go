@@ -0,0 +1,239 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+type participantsRepository struct {
+	db *sql.DB
+}
+
+func NewParticipantsRepository(connect string) (repository.ParticipantsRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &participantsRepository{db}, nil
+}
+
+func (r *participantsRepository) Create(ctx context.Context, p *models.Participant) error {
+	const op = "postgresql.ParticipantsRepository.Create"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	INSERT INTO game_creator.participants (participant_id, name, kind, subject)
+	VALUES ($1, $2, $3, $4)
+	`
+
+	_, err = tx.ExecContext(ctx, query, p.ParticipantID, p.Name, p.Kind, p.Subject)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to insert into participants: %w", op, dbError(err))
+	}
+
+	if err := insertMembers(ctx, tx, p.ParticipantID, p.Members); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func insertMembers(ctx context.Context, tx executor, teamId uuid.UUID, members []models.TeamMember) error {
+	query := `
+	INSERT INTO game_creator.team_members (team_id, player_id, role)
+	VALUES ($1, $2, $3)
+	`
+
+	for _, m := range members {
+		if _, err := tx.ExecContext(ctx, query, teamId, m.PlayerID, m.Role); err != nil {
+			return fmt.Errorf("Failed to insert into team_members: %w", dbError(err))
+		}
+	}
+
+	return nil
+}
+
+func (r *participantsRepository) FetchById(ctx context.Context, id uuid.UUID) (models.Participant, error) {
+	const op = "postgresql.ParticipantsRepository.FetchById"
+
+	query := `
+	SELECT participant_id, name, kind, subject
+	FROM game_creator.participants WHERE participant_id = $1
+	`
+
+	row := conn(ctx, r.db).QueryRowContext(ctx, query, id)
+
+	var participant models.Participant
+	err := row.Scan(&participant.ParticipantID, &participant.Name, &participant.Kind, &participant.Subject)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Participant{}, fmt.Errorf("%s: %w", op, domain.NotFound("participant", id))
+		}
+		return models.Participant{}, fmt.Errorf("%s: Failed to get participant from db: %w", op, dbError(err))
+	}
+
+	membersQuery := `
+	SELECT player_id, role
+	FROM game_creator.team_members WHERE team_id = $1
+	ORDER BY player_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, membersQuery, id)
+	if err != nil {
+		return models.Participant{}, fmt.Errorf("%s: Failed to get team members from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var member models.TeamMember
+		if err := rows.Scan(&member.PlayerID, &member.Role); err != nil {
+			return models.Participant{}, fmt.Errorf("%s: Failed to scan team member: %w", op, dbError(err))
+		}
+		participant.Members = append(participant.Members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return models.Participant{}, fmt.Errorf("%s: Failed to get team members from db: %w", op, dbError(err))
+	}
+
+	return participant, nil
+}
+
+// Update changes name, kind and subject when they are set and replaces the roster
+// when Members is not nil.
+func (r *participantsRepository) Update(ctx context.Context, updated *models.Participant) error {
+	const op = "postgresql.ParticipantsRepository.Update"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	UPDATE game_creator.participants
+	SET
+	    name=COALESCE(NULLIF($1, ''), name),
+	    kind=COALESCE(NULLIF($2, ''), kind),
+	    subject=COALESCE(NULLIF($3, ''), subject)
+	WHERE participant_id=$4
+	`
+
+	result, err := tx.ExecContext(ctx, query, updated.Name, updated.Kind, updated.Subject, updated.ParticipantID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to update participant: %w", op, dbError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, domain.NotFound("participant", updated.ParticipantID))
+	}
+
+	if updated.Members != nil {
+		_, err = tx.ExecContext(ctx, `DELETE FROM game_creator.team_members WHERE team_id = $1`, updated.ParticipantID)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: Failed to delete from team_members: %w", op, dbError(err))
+		}
+
+		if err := insertMembers(ctx, tx, updated.ParticipantID, updated.Members); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: %w", op, dbError(err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *participantsRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	const op = "postgresql.ParticipantsRepository.DeleteById"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	DELETE FROM game_creator.participants WHERE participant_id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, query, id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to delete from participants: %w", op, dbError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+// Tournaments returns the tournaments whose games the participant plays in,
+// itself or on one of its teams.
+func (r *participantsRepository) Tournaments(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	const op = "postgresql.ParticipantsRepository.Tournaments"
+
+	query := `
+	SELECT DISTINCT g.tournament_id
+	FROM game_creator.games g
+	JOIN game_creator.game_participants gp ON gp.game_id = g.game_id
+	WHERE g.tournament_id IS NOT NULL
+	  AND (gp.participant_id = $1
+	       OR gp.participant_id IN (SELECT team_id FROM game_creator.team_members WHERE player_id = $1))
+	ORDER BY g.tournament_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get tournaments from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var tournaments []uuid.UUID
+	for rows.Next() {
+		var tournamentId uuid.UUID
+		if err := rows.Scan(&tournamentId); err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan tournament: %w", op, dbError(err))
+		}
+		tournaments = append(tournaments, tournamentId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: Failed to get tournaments from db: %w", op, dbError(err))
+	}
+
+	return tournaments, nil
+}
@@ -0,0 +1,326 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+type resultReviewsRepository struct {
+	db *sql.DB
+}
+
+func NewResultReviewsRepository(connect string) (repository.ResultReviewsRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &resultReviewsRepository{db}, nil
+}
+
+const reportColumns = `report_id, game_id, participant_id, subject, outcome, winner_id, forfeited_by, placements, comment, created_at`
+
+func scanReport(row interface{ Scan(dest ...any) error }) (models.ResultReport, error) {
+	var (
+		r          models.ResultReport
+		winnerId   uuid.NullUUID
+		placements []byte
+	)
+	err := row.Scan(
+		&r.ReportID,
+		&r.GameID,
+		&r.ParticipantID,
+		&r.Subject,
+		&r.Outcome,
+		&winnerId,
+		&r.ForfeitedBy,
+		&placements,
+		&r.Comment,
+		&r.CreatedAt,
+	)
+	if err != nil {
+		return models.ResultReport{}, err
+	}
+	r.WinnerID = winnerId.UUID
+	if err := json.Unmarshal(placements, &r.Placements); err != nil {
+		return models.ResultReport{}, err
+	}
+	return r, nil
+}
+
+func (r *resultReviewsRepository) FetchByGame(ctx context.Context, gameId uuid.UUID) (models.ResultReview, error) {
+	const op = "postgresql.ResultReviewsRepository.FetchByGame"
+
+	query := `
+	SELECT game_id, status, result_id, resolved_by, resolution, created_at, updated_at
+	FROM game_creator.result_reviews WHERE game_id = $1
+	`
+
+	var review models.ResultReview
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, gameId).Scan(
+		&review.GameID,
+		&review.Status,
+		&review.ResultID,
+		&review.ResolvedBy,
+		&review.Resolution,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.ResultReview{}, fmt.Errorf("%s: %w", op, domain.NotFound("result review", gameId))
+		}
+		return models.ResultReview{}, fmt.Errorf("%s: Failed to get result review from db: %w", op, dbError(err))
+	}
+
+	if review.Reports, err = r.fetchReports(ctx, gameId); err != nil {
+		return models.ResultReview{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if review.Comments, err = r.fetchComments(ctx, gameId); err != nil {
+		return models.ResultReview{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return review, nil
+}
+
+func (r *resultReviewsRepository) fetchReports(ctx context.Context, gameId uuid.UUID) ([]models.ResultReport, error) {
+	query := `
+	SELECT ` + reportColumns + `
+	FROM game_creator.result_reports WHERE game_id = $1
+	ORDER BY created_at, report_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, gameId)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get result reports from db: %w", dbError(err))
+	}
+	defer rows.Close()
+
+	var reports []models.ResultReport
+	index := make(map[uuid.UUID]int)
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to scan result report: %w", dbError(err))
+		}
+		index[report.ReportID] = len(reports)
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Failed to get result reports from db: %w", dbError(err))
+	}
+
+	attachmentsQuery := `
+	SELECT ` + attachmentColumns + `
+	FROM game_creator.result_attachments
+	WHERE report_id IN (SELECT report_id FROM game_creator.result_reports WHERE game_id = $1)
+	ORDER BY created_at, attachment_id
+	`
+
+	attachmentRows, err := conn(ctx, r.db).QueryContext(ctx, attachmentsQuery, gameId)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get attachments from db: %w", dbError(err))
+	}
+	defer attachmentRows.Close()
+
+	for attachmentRows.Next() {
+		a, err := scanAttachment(attachmentRows)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to scan attachment: %w", dbError(err))
+		}
+		if i, ok := index[a.ReportID.UUID]; ok {
+			reports[i].Attachments = append(reports[i].Attachments, a)
+		}
+	}
+	if err := attachmentRows.Err(); err != nil {
+		return nil, fmt.Errorf("Failed to get attachments from db: %w", dbError(err))
+	}
+
+	return reports, nil
+}
+
+func (r *resultReviewsRepository) fetchComments(ctx context.Context, gameId uuid.UUID) ([]models.DisputeComment, error) {
+	query := `
+	SELECT comment_id, game_id, subject, body, created_at
+	FROM game_creator.dispute_comments WHERE game_id = $1
+	ORDER BY created_at, comment_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, gameId)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get dispute comments from db: %w", dbError(err))
+	}
+	defer rows.Close()
+
+	var comments []models.DisputeComment
+	for rows.Next() {
+		var c models.DisputeComment
+		if err := rows.Scan(&c.CommentID, &c.GameID, &c.Subject, &c.Body, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("Failed to scan dispute comment: %w", dbError(err))
+		}
+		comments = append(comments, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Failed to get dispute comments from db: %w", dbError(err))
+	}
+
+	return comments, nil
+}
+
+func (r *resultReviewsRepository) FetchReport(ctx context.Context, id uuid.UUID) (models.ResultReport, error) {
+	const op = "postgresql.ResultReviewsRepository.FetchReport"
+
+	query := `
+	SELECT ` + reportColumns + `
+	FROM game_creator.result_reports WHERE report_id = $1
+	`
+
+	report, err := scanReport(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.ResultReport{}, fmt.Errorf("%s: %w", op, domain.NotFound("result report", id))
+		}
+		return models.ResultReport{}, fmt.Errorf("%s: Failed to get result report from db: %w", op, dbError(err))
+	}
+
+	return report, nil
+}
+
+func (r *resultReviewsRepository) SaveReport(ctx context.Context, report *models.ResultReport) error {
+	const op = "postgresql.ResultReviewsRepository.SaveReport"
+
+	placements, err := json.Marshal(report.Placements)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to encode placements: %w", op, err)
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	reviewQuery := `
+	INSERT INTO game_creator.result_reviews (game_id)
+	VALUES ($1)
+	ON CONFLICT (game_id) DO NOTHING
+	`
+
+	if _, err := tx.ExecContext(ctx, reviewQuery, report.GameID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to insert into result_reviews: %w", op, dbError(err))
+	}
+
+	query := `
+	INSERT INTO game_creator.result_reports (report_id, game_id, participant_id, subject, outcome, winner_id, forfeited_by, placements, comment)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (game_id, participant_id) DO UPDATE
+	SET subject = EXCLUDED.subject,
+	    outcome = EXCLUDED.outcome,
+	    winner_id = EXCLUDED.winner_id,
+	    forfeited_by = EXCLUDED.forfeited_by,
+	    placements = EXCLUDED.placements,
+	    comment = EXCLUDED.comment,
+	    created_at = now()
+	RETURNING report_id, created_at
+	`
+
+	err = tx.QueryRowContext(ctx, query,
+		report.ReportID,
+		report.GameID,
+		report.ParticipantID,
+		report.Subject,
+		report.Outcome,
+		uuid.NullUUID{UUID: report.WinnerID, Valid: report.WinnerID != uuid.Nil},
+		report.ForfeitedBy,
+		string(placements),
+		report.Comment,
+	).Scan(&report.ReportID, &report.CreatedAt)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to insert into result_reports: %w", op, dbError(err))
+	}
+
+	touchQuery := `
+	UPDATE game_creator.result_reviews SET updated_at = now() WHERE game_id = $1
+	`
+
+	if _, err := tx.ExecContext(ctx, touchQuery, report.GameID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to update result_reviews: %w", op, dbError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *resultReviewsRepository) AddComment(ctx context.Context, c *models.DisputeComment) error {
+	const op = "postgresql.ResultReviewsRepository.AddComment"
+
+	query := `
+	INSERT INTO game_creator.dispute_comments (comment_id, game_id, subject, body)
+	VALUES ($1, $2, $3, $4)
+	RETURNING created_at
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, c.CommentID, c.GameID, c.Subject, c.Body).Scan(&c.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to insert into dispute_comments: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *resultReviewsRepository) SetStatus(ctx context.Context, review *models.ResultReview) error {
+	const op = "postgresql.ResultReviewsRepository.SetStatus"
+
+	query := `
+	UPDATE game_creator.result_reviews
+	SET status = $1, result_id = $2, resolved_by = $3, resolution = $4, updated_at = now()
+	WHERE game_id = $5
+	RETURNING updated_at
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		review.Status,
+		review.ResultID,
+		review.ResolvedBy,
+		review.Resolution,
+		review.GameID,
+	).Scan(&review.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%s: %w", op, domain.NotFound("result review", review.GameID))
+		}
+		return fmt.Errorf("%s: Failed to update result_reviews: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *resultReviewsRepository) DeleteByGame(ctx context.Context, gameId uuid.UUID) error {
+	const op = "postgresql.ResultReviewsRepository.DeleteByGame"
+
+	query := `
+	DELETE FROM game_creator.result_reviews WHERE game_id = $1
+	`
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, gameId); err != nil {
+		return fmt.Errorf("%s: Failed to delete from result_reviews: %w", op, dbError(err))
+	}
+
+	return nil
+}
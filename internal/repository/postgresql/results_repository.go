@@ -3,9 +3,13 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"strings"
+	"time"
+	"tournaments-core/internal/domain"
 	"tournaments-core/internal/domain/models"
 	"tournaments-core/internal/domain/ports/repository"
 )
@@ -31,105 +35,426 @@ func NewResultsRepository(connect string) (repository.ResultsRepository, error)
 func (r *resultsRepository) Create(ctx context.Context, res *models.Result) error {
 	const op = "postgresql.ResultsRepository.Create"
 
-	tx, err := r.db.Begin()
+	tx, err := begin(ctx, r.db)
 	if err != nil {
-		return fmt.Errorf("%s: Failed to begin transaction: %w", op, err)
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
 	}
 
 	query := `
-	INSERT INTO game_creator.results (result_id, game_id, winner_id, comment)
-	VALUES ($1, $2, $3, $4)
+	INSERT INTO game_creator.results (result_id, game_id, winner_id, comment, outcome, forfeited_by)
+	VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
-	_, err = tx.ExecContext(ctx, query, res.ResultID, res.GameID, res.WinnerID, res.Comment)
+	_, err = tx.ExecContext(ctx, query, res.ResultID, res.GameID, nullWinner(res.WinnerID), res.Comment, res.Outcome, res.ForfeitedBy)
 	if err != nil {
 		tx.Rollback()
-		return fmt.Errorf("%s: Failed to insert into results: %w", op, err)
+		return fmt.Errorf("%s: Failed to insert into results: %w", op, dbError(err))
+	}
+
+	if err := insertPlacements(ctx, tx, res.ResultID, res.Placements); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	if err := recordResult(ctx, tx, models.EventCreated, res.ResultID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("%s: Failed to commit transaction: %w", op, err)
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func nullWinner(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+const resultColumns = `r.result_id, r.game_id, r.winner_id, r.comment, r.outcome, r.forfeited_by, r.created_at, r.version`
+
+func scanResult(row interface{ Scan(dest ...any) error }) (models.Result, error) {
+	var result models.Result
+	var winner uuid.NullUUID
+	err := row.Scan(
+		&result.ResultID,
+		&result.GameID,
+		&winner,
+		&result.Comment,
+		&result.Outcome,
+		&result.ForfeitedBy,
+		&result.CreatedAt,
+		&result.Version,
+	)
+	result.WinnerID = winner.UUID
+	return result, err
+}
+
+func insertPlacements(ctx context.Context, tx executor, resultId uuid.UUID, placements []models.Placement) error {
+	query := `
+	INSERT INTO game_creator.result_placements (result_id, participant_id, place, score)
+	VALUES ($1, $2, $3, $4)
+	`
+
+	for _, p := range placements {
+		if _, err := tx.ExecContext(ctx, query, resultId, p.ParticipantID, p.Place, p.Score); err != nil {
+			return fmt.Errorf("Failed to insert into result_placements: %w", dbError(err))
+		}
 	}
 
 	return nil
 }
 
+// fetchPlacements loads placements grouped by result, the filter is appended
+// to the select from result_placements aliased as rp.
+func fetchPlacements(ctx context.Context, q queryer, filter string, args ...any) (map[uuid.UUID][]models.Placement, error) {
+	query := `
+	SELECT rp.result_id, rp.participant_id, rp.place, rp.score
+	FROM game_creator.result_placements rp ` + filter + `
+	ORDER BY rp.result_id, rp.place, rp.participant_id
+	`
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get result placements from db: %w", dbError(err))
+	}
+	defer rows.Close()
+
+	placements := make(map[uuid.UUID][]models.Placement)
+	for rows.Next() {
+		var resultId uuid.UUID
+		var p models.Placement
+		if err := rows.Scan(&resultId, &p.ParticipantID, &p.Place, &p.Score); err != nil {
+			return nil, fmt.Errorf("Failed to scan result placement: %w", dbError(err))
+		}
+		placements[resultId] = append(placements[resultId], p)
+	}
+
+	return placements, rows.Err()
+}
+
 func (r *resultsRepository) FetchById(ctx context.Context, id uuid.UUID) (models.Result, error) {
 	const op = "postgresql.ResultsRepository.FetchById"
 
+	result, err := fetchResult(ctx, conn(ctx, r.db), id)
+	if err != nil {
+		return models.Result{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// fetchResult reads a result with its placements, inside a transaction when
+// q is one.
+func fetchResult(ctx context.Context, q queryer, id uuid.UUID) (models.Result, error) {
 	query := `
-	SELECT result_id, game_id, winner_id, comment
-	FROM game_creator.results WHERE result_id = $1
+	SELECT ` + resultColumns + `
+	FROM game_creator.results r WHERE r.result_id = $1
 	`
 
-	row := r.db.QueryRowContext(ctx, query, id)
+	result, err := scanResult(q.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Result{}, domain.NotFound("result", id)
+		}
+		return models.Result{}, fmt.Errorf("Failed to get result from db: %w", dbError(err))
+	}
+
+	placements, err := fetchPlacements(ctx, q, `WHERE rp.result_id = $1`, id)
+	if err != nil {
+		return models.Result{}, err
+	}
+	result.Placements = placements[result.ResultID]
 
-	var result models.Result
-	err := row.Scan(&result.ResultID, &result.GameID, &result.WinnerID, &result.Comment)
+	return result, nil
+}
+
+func (r *resultsRepository) FetchByTournament(ctx context.Context, tournamentId uuid.UUID) ([]models.Result, error) {
+	const op = "postgresql.ResultsRepository.FetchByTournament"
+
+	query := `
+	SELECT ` + resultColumns + `
+	FROM game_creator.results r
+	JOIN game_creator.games g ON g.game_id = r.game_id
+	WHERE g.tournament_id = $1
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tournamentId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get results from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var results []models.Result
+	for rows.Next() {
+		result, err := scanResult(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan result: %w", op, dbError(err))
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: Failed to get results from db: %w", op, dbError(err))
+	}
+
+	placements, err := fetchPlacements(ctx, conn(ctx, r.db), `
+	JOIN game_creator.results r ON r.result_id = rp.result_id
+	JOIN game_creator.games g ON g.game_id = r.game_id
+	WHERE g.tournament_id = $1`, tournamentId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+	for i := range results {
+		results[i].Placements = placements[results[i].ResultID]
+	}
+
+	return results, nil
+}
+
+// FetchByGameId returns the latest result recorded for the game.
+func (r *resultsRepository) FetchByGameId(ctx context.Context, gameId uuid.UUID) (models.Result, error) {
+	const op = "postgresql.ResultsRepository.FetchByGameId"
+
+	query := `
+	SELECT ` + resultColumns + `
+	FROM game_creator.results r WHERE r.game_id = $1
+	ORDER BY r.created_at DESC, r.result_id DESC
+	LIMIT 1
+	`
+
+	row := conn(ctx, r.db).QueryRowContext(ctx, query, gameId)
+
+	result, err := scanResult(row)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.Result{}, fmt.Errorf("%s: Result not found", op)
+			return models.Result{}, fmt.Errorf("%s: %w", op, domain.NotFound("result for game", gameId))
 		}
-		return models.Result{}, fmt.Errorf("%s: Failed to get result from db: %w", op, err)
+		return models.Result{}, fmt.Errorf("%s: Failed to get result from db: %w", op, dbError(err))
 	}
 
+	placements, err := fetchPlacements(ctx, conn(ctx, r.db), `WHERE rp.result_id = $1`, result.ResultID)
+	if err != nil {
+		return models.Result{}, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+	result.Placements = placements[result.ResultID]
+
 	return result, nil
 }
 
-func (r *resultsRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+// resultsSort names the only order results are listed in, it guards page
+// tokens issued by other listings.
+const resultsSort = "created_at"
+
+func (r *resultsRepository) List(ctx context.Context, f models.ResultsFilter) (models.ResultsPage, error) {
+	const op = "postgresql.ResultsRepository.List"
+
+	var p placeholders
+	var conds []string
+	if f.GameID.Valid {
+		conds = append(conds, "r.game_id = "+p.add(f.GameID.UUID))
+	}
+	if f.WinnerID.Valid {
+		conds = append(conds, `r.outcome NOT IN ('draw', 'cancelled') AND EXISTS (
+		SELECT 1 FROM game_creator.result_placements rp
+		WHERE rp.result_id = r.result_id AND rp.place = 1 AND rp.participant_id = `+p.add(f.WinnerID.UUID)+`)`)
+	}
+	if !f.CreatedFrom.IsZero() {
+		conds = append(conds, "r.created_at >= "+p.add(f.CreatedFrom))
+	}
+	if !f.CreatedTo.IsZero() {
+		conds = append(conds, "r.created_at < "+p.add(f.CreatedTo))
+	}
+
+	direction, compare := "ASC", ">"
+	if f.Descending {
+		direction, compare = "DESC", "<"
+	}
+	if f.Page.Token != "" {
+		c, err := decodeCursor(f.Page.Token, resultsSort)
+		if err != nil {
+			return models.ResultsPage{}, fmt.Errorf("%s: %w", op, dbError(err))
+		}
+		key, err := time.Parse(time.RFC3339Nano, c.Key)
+		if err != nil {
+			return models.ResultsPage{}, fmt.Errorf("%s: %w", op, errMalformedToken)
+		}
+		conds = append(conds, fmt.Sprintf("(r.created_at, r.result_id) %s (%s, %s)", compare, p.add(key), p.add(c.ID)))
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	limit := f.Page.Limit()
+	query := `
+	SELECT ` + resultColumns + `
+	FROM game_creator.results r
+	` + where + `
+	ORDER BY r.created_at ` + direction + `, r.result_id ` + direction + `
+	LIMIT ` + p.add(limit+1)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, p.args...)
+	if err != nil {
+		return models.ResultsPage{}, fmt.Errorf("%s: Failed to get results from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var page models.ResultsPage
+	for rows.Next() {
+		result, err := scanResult(rows)
+		if err != nil {
+			return models.ResultsPage{}, fmt.Errorf("%s: Failed to scan result: %w", op, dbError(err))
+		}
+		page.Results = append(page.Results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return models.ResultsPage{}, fmt.Errorf("%s: Failed to get results from db: %w", op, dbError(err))
+	}
+
+	if len(page.Results) > limit {
+		page.Results = page.Results[:limit]
+		last := page.Results[limit-1]
+		page.NextPageToken = encodeCursor(cursor{
+			Sort: resultsSort,
+			Key:  last.CreatedAt.Format(time.RFC3339Nano),
+			ID:   last.ResultID,
+		})
+	}
+
+	if len(page.Results) == 0 {
+		return page, nil
+	}
+	ids := make([]string, 0, len(page.Results))
+	for _, res := range page.Results {
+		ids = append(ids, res.ResultID.String())
+	}
+	placements, err := fetchPlacements(ctx, conn(ctx, r.db), `WHERE rp.result_id = ANY($1::uuid[])`, pq.StringArray(ids))
+	if err != nil {
+		return models.ResultsPage{}, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+	for i := range page.Results {
+		page.Results[i].Placements = placements[page.Results[i].ResultID]
+	}
+
+	return page, nil
+}
+
+// DeleteById removes the result, a non-zero version must match the stored one.
+func (r *resultsRepository) DeleteById(ctx context.Context, id uuid.UUID, version int64) error {
 	const op = "postgresql.ResultsRepository.DeleteById"
 
 	query := `
-	DELETE FROM game_creator.results WHERE result_id = $1
+	DELETE FROM game_creator.results WHERE result_id = $1 AND ($2::bigint = 0 OR version = $2)
 	`
 
-	tx, err := r.db.Begin()
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	// The deletion event carries the last state of the result.
+	last, err := fetchResult(ctx, tx, id)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, id, version)
 	if err != nil {
-		return fmt.Errorf("%s: Failed to begin transaction: %w", op, err)
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to delete from results: %w", op, dbError(err))
 	}
 
-	_, err = tx.ExecContext(ctx, query, id)
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		tx.Rollback()
-		return fmt.Errorf("%s: Failed to delete from results: %w", op, err)
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 && version != 0 {
+		err = versionConflict(ctx, tx, "game_creator.results", "result_id", "result", id)
+		tx.Rollback()
+		return fmt.Errorf("%s: result with id %s: %w", op, id, err)
+	}
+
+	if rowsAffected > 0 {
+		if err := recordResultState(ctx, tx, models.EventDeleted, last); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("%s: Failed to commit transaction: %w", op, err)
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
 	}
 
 	return nil
 }
 
+// Update overwrites the result and replaces its placements when Placements
+// is not nil.
 func (r *resultsRepository) Update(ctx context.Context, updated *models.Result) error {
 	const op = "postgresql.ResultsRepository.Update"
 
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
 	query := `
         UPDATE game_creator.results 
         SET game_id = $1, 
             winner_id = $2, 
-            comment = $3
-        WHERE result_id = $4
+            comment = $3,
+            outcome = $4,
+            forfeited_by = $5,
+            version = version + 1
+        WHERE result_id = $6 AND ($7::bigint = 0 OR version = $7)
+        RETURNING version
     `
 
-	result, err := r.db.ExecContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		updated.GameID,
-		updated.WinnerID,
+		nullWinner(updated.WinnerID),
 		updated.Comment,
+		updated.Outcome,
+		updated.ForfeitedBy,
 		updated.ResultID,
-	)
+		updated.Version,
+	).Scan(&updated.Version)
+	if err == sql.ErrNoRows {
+		err = versionConflict(ctx, tx, "game_creator.results", "result_id", "result", updated.ResultID)
+		tx.Rollback()
+		return fmt.Errorf("%s: result with id %s: %w", op, updated.ResultID, err)
+	}
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, dbError(err))
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("%s: failed to get rows affected: %w", op, err)
+	if updated.Placements != nil {
+		_, err = tx.ExecContext(ctx, `DELETE FROM game_creator.result_placements WHERE result_id = $1`, updated.ResultID)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: Failed to delete from result_placements: %w", op, dbError(err))
+		}
+
+		if err := insertPlacements(ctx, tx, updated.ResultID, updated.Placements); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: %w", op, dbError(err))
+		}
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("%s: result with id %s not found", op, updated.ResultID)
+	if err := recordResult(ctx, tx, models.EventUpdated, updated.ResultID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
 	}
 
 	return nil
@@ -0,0 +1,129 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+type tournamentGrantsRepository struct {
+	db *sql.DB
+}
+
+func NewTournamentGrantsRepository(connect string) (repository.TournamentGrantsRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &tournamentGrantsRepository{db}, nil
+}
+
+const grantColumns = `tournament_id, subject, access, granted_by, created_at`
+
+func scanGrant(row interface{ Scan(dest ...any) error }) (models.TournamentGrant, error) {
+	var g models.TournamentGrant
+	err := row.Scan(&g.TournamentID, &g.Subject, &g.Access, &g.GrantedBy, &g.CreatedAt)
+	return g, err
+}
+
+func (r *tournamentGrantsRepository) Fetch(ctx context.Context, tournamentId uuid.UUID, subject string) (models.TournamentGrant, error) {
+	const op = "postgresql.TournamentGrantsRepository.Fetch"
+
+	query := `
+	SELECT ` + grantColumns + `
+	FROM game_creator.tournament_grants WHERE tournament_id = $1 AND subject = $2
+	`
+
+	g, err := scanGrant(conn(ctx, r.db).QueryRowContext(ctx, query, tournamentId, subject))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.TournamentGrant{}, fmt.Errorf("%s: %w", op, domain.NotFound("tournament grant", subject))
+		}
+		return models.TournamentGrant{}, fmt.Errorf("%s: Failed to get tournament grant from db: %w", op, dbError(err))
+	}
+
+	return g, nil
+}
+
+func (r *tournamentGrantsRepository) FetchByTournament(ctx context.Context, tournamentId uuid.UUID) ([]models.TournamentGrant, error) {
+	const op = "postgresql.TournamentGrantsRepository.FetchByTournament"
+
+	query := `
+	SELECT ` + grantColumns + `
+	FROM game_creator.tournament_grants WHERE tournament_id = $1
+	ORDER BY created_at, subject
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tournamentId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get tournament grants from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var grants []models.TournamentGrant
+	for rows.Next() {
+		g, err := scanGrant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan tournament grant: %w", op, dbError(err))
+		}
+		grants = append(grants, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: Failed to get tournament grants from db: %w", op, dbError(err))
+	}
+
+	return grants, nil
+}
+
+func (r *tournamentGrantsRepository) Grant(ctx context.Context, g *models.TournamentGrant) error {
+	const op = "postgresql.TournamentGrantsRepository.Grant"
+
+	query := `
+	INSERT INTO game_creator.tournament_grants (tournament_id, subject, access, granted_by)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (tournament_id, subject) DO UPDATE
+	SET access = EXCLUDED.access, granted_by = EXCLUDED.granted_by, created_at = now()
+	RETURNING created_at
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, g.TournamentID, g.Subject, g.Access, g.GrantedBy).Scan(&g.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to insert into tournament_grants: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *tournamentGrantsRepository) Revoke(ctx context.Context, tournamentId uuid.UUID, subject string) error {
+	const op = "postgresql.TournamentGrantsRepository.Revoke"
+
+	query := `
+	DELETE FROM game_creator.tournament_grants WHERE tournament_id = $1 AND subject = $2
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, tournamentId, subject)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to delete from tournament_grants: %w", op, dbError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, domain.NotFound("tournament grant", subject))
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+type tournamentsRepository struct {
+	db *sql.DB
+}
+
+func NewTournamentsRepository(connect string) (repository.TournamentsRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &tournamentsRepository{db}, nil
+}
+
+func (r *tournamentsRepository) Create(ctx context.Context, t *models.Tournament) error {
+	const op = "postgresql.TournamentsRepository.Create"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	INSERT INTO game_creator.tournaments (tournament_id, name, game_type_id, format, starts_at, ends_at, status,
+	                                      grand_final_reset, slot_length_seconds, legs, groups, group_advance, swiss_rounds)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err = tx.ExecContext(ctx, query,
+		t.TournamentID,
+		t.Name,
+		t.GameTypeID,
+		t.Format,
+		t.StartsAt,
+		t.EndsAt,
+		t.Status,
+		t.GrandFinalReset,
+		int64(t.SlotLength/time.Second),
+		t.Legs,
+		t.Groups,
+		t.GroupAdvance,
+		t.SwissRounds,
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to insert into tournaments: %w", op, dbError(err))
+	}
+
+	if err := recordTournament(ctx, tx, models.EventCreated, t.TournamentID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *tournamentsRepository) FetchById(ctx context.Context, id uuid.UUID) (models.Tournament, error) {
+	const op = "postgresql.TournamentsRepository.FetchById"
+
+	tournament, err := fetchTournament(ctx, conn(ctx, r.db), id)
+	if err != nil {
+		return models.Tournament{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tournament, nil
+}
+
+// fetchTournament reads a tournament, inside a transaction when q is one.
+func fetchTournament(ctx context.Context, q queryer, id uuid.UUID) (models.Tournament, error) {
+	query := `
+	SELECT tournament_id, name, game_type_id, format, starts_at, ends_at, status,
+	       grand_final_reset, slot_length_seconds, legs, groups, group_advance, swiss_rounds
+	FROM game_creator.tournaments WHERE tournament_id = $1
+	`
+
+	row := q.QueryRowContext(ctx, query, id)
+
+	var tournament models.Tournament
+	var slotLength int64
+	err := row.Scan(
+		&tournament.TournamentID,
+		&tournament.Name,
+		&tournament.GameTypeID,
+		&tournament.Format,
+		&tournament.StartsAt,
+		&tournament.EndsAt,
+		&tournament.Status,
+		&tournament.GrandFinalReset,
+		&slotLength,
+		&tournament.Legs,
+		&tournament.Groups,
+		&tournament.GroupAdvance,
+		&tournament.SwissRounds,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Tournament{}, domain.NotFound("tournament", id)
+		}
+		return models.Tournament{}, fmt.Errorf("Failed to get tournament from db: %w", dbError(err))
+	}
+	tournament.SlotLength = time.Duration(slotLength) * time.Second
+
+	return tournament, nil
+}
+
+func (r *tournamentsRepository) Update(ctx context.Context, updated *models.Tournament) error {
+	const op = "postgresql.TournamentsRepository.Update"
+
+	query := `
+	UPDATE game_creator.tournaments
+	SET
+	    name=COALESCE(NULLIF($1, ''), name),
+	    game_type_id=COALESCE($2, game_type_id),
+	    format=COALESCE(NULLIF($3, ''), format),
+	    starts_at=COALESCE($4, starts_at),
+	    ends_at=COALESCE($5, ends_at),
+	    status=COALESCE(NULLIF($6, ''), status)
+	WHERE tournament_id=$7
+	`
+
+	var gameTypeId uuid.NullUUID
+	if updated.GameTypeID != uuid.Nil {
+		gameTypeId = uuid.NullUUID{UUID: updated.GameTypeID, Valid: true}
+	}
+
+	var startsAt, endsAt sql.NullTime
+	if !updated.StartsAt.IsZero() {
+		startsAt = sql.NullTime{Time: updated.StartsAt, Valid: true}
+	}
+	if !updated.EndsAt.IsZero() {
+		endsAt = sql.NullTime{Time: updated.EndsAt, Valid: true}
+	}
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	result, err := tx.ExecContext(ctx, query,
+		updated.Name,
+		gameTypeId,
+		updated.Format,
+		startsAt,
+		endsAt,
+		updated.Status,
+		updated.TournamentID,
+	)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to update tournament: %w", op, dbError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, domain.NotFound("tournament", updated.TournamentID))
+	}
+
+	if err := recordTournament(ctx, tx, models.EventUpdated, updated.TournamentID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *tournamentsRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	const op = "postgresql.TournamentsRepository.DeleteById"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	// The deletion event carries the last state of the tournament.
+	last, err := fetchTournament(ctx, tx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		tx.Rollback()
+		return nil
+	}
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+	DELETE FROM game_creator.tournaments WHERE tournament_id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, query, id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to delete from tournaments: %w", op, dbError(err))
+	}
+
+	if err := writeOutbox(ctx, tx, models.TournamentEvent(models.EventDeleted, last)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+// queryer is either the database or a transaction.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// executor is a queryer that writes as well.
+type executor interface {
+	queryer
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// txKey carries the transaction a Transactor runs its work in.
+type txKey struct{}
+
+// transaction is the transaction a repository method writes in. Methods
+// called within a Transactor join its transaction, committing and rolling
+// back is then left to the Transactor.
+type transaction struct {
+	*sql.Tx
+	joined bool
+}
+
+func (t transaction) Commit() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t transaction) Rollback() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
+// begin joins the transaction of the context or starts a new one.
+func begin(ctx context.Context, db *sql.DB) (transaction, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return transaction{Tx: tx, joined: true}, nil
+	}
+	tx, err := db.Begin()
+	return transaction{Tx: tx}, err
+}
+
+// conn reads and writes in the transaction of the context, if there is one,
+// so that work within a Transactor sees its own changes.
+func conn(ctx context.Context, db *sql.DB) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+type transactor struct {
+	db *sql.DB
+}
+
+func NewTransactor(connect string) (repository.Transactor, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &transactor{db}, nil
+}
+
+func (t *transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	const op = "postgresql.Transactor.WithinTx"
+
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain"
+)
+
+// versionConflict explains why a versioned write touched no rows: either the
+// row is gone or its version moved on.
+func versionConflict(ctx context.Context, tx executor, table, idColumn, resource string, id uuid.UUID) error {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE %s = $1)`, table, idColumn)
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&exists); err != nil {
+		return fmt.Errorf("Failed to check version: %w", dbError(err))
+	}
+	if !exists {
+		return domain.NotFound(resource, id)
+	}
+	return domain.ErrStaleVersion
+}
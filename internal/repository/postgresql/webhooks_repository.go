@@ -0,0 +1,458 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"sort"
+	"strings"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+type webhooksRepository struct {
+	db *sql.DB
+}
+
+func NewWebhooksRepository(connect string) (repository.WebhooksRepository, error) {
+	db, err := sql.Open("postgres", connect)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &webhooksRepository{db}, nil
+}
+
+const webhookColumns = `webhook_id, url, secret, event_types, game_statuses, tournament_id, active, created_by, created_at`
+
+func scanWebhook(row interface{ Scan(dest ...any) error }) (models.Webhook, error) {
+	var w models.Webhook
+	var eventTypes, gameStatuses pq.StringArray
+	err := row.Scan(
+		&w.WebhookID,
+		&w.URL,
+		&w.Secret,
+		&eventTypes,
+		&gameStatuses,
+		&w.TournamentID,
+		&w.Active,
+		&w.CreatedBy,
+		&w.CreatedAt,
+	)
+	w.EventTypes = eventTypes
+	for _, s := range gameStatuses {
+		w.GameStatuses = append(w.GameStatuses, models.GameStatus(s))
+	}
+	return w, err
+}
+
+func gameStatusArray(statuses []models.GameStatus) pq.StringArray {
+	array := make(pq.StringArray, 0, len(statuses))
+	for _, s := range statuses {
+		array = append(array, string(s))
+	}
+	return array
+}
+
+func (r *webhooksRepository) Create(ctx context.Context, w *models.Webhook) error {
+	const op = "postgresql.WebhooksRepository.Create"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	INSERT INTO game_creator.webhooks (webhook_id, url, secret, event_types, game_statuses, tournament_id, active, created_by)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	RETURNING created_at
+	`
+
+	err = tx.QueryRowContext(ctx, query,
+		w.WebhookID,
+		w.URL,
+		w.Secret,
+		pq.StringArray(w.EventTypes),
+		gameStatusArray(w.GameStatuses),
+		w.TournamentID,
+		w.Active,
+		w.CreatedBy,
+	).Scan(&w.CreatedAt)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to insert into webhooks: %w", op, dbError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *webhooksRepository) FetchById(ctx context.Context, id uuid.UUID) (models.Webhook, error) {
+	const op = "postgresql.WebhooksRepository.FetchById"
+
+	query := `
+	SELECT ` + webhookColumns + `
+	FROM game_creator.webhooks WHERE webhook_id = $1
+	`
+
+	w, err := scanWebhook(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Webhook{}, fmt.Errorf("%s: %w", op, domain.NotFound("webhook", id))
+		}
+		return models.Webhook{}, fmt.Errorf("%s: Failed to get webhook from db: %w", op, dbError(err))
+	}
+
+	return w, nil
+}
+
+func (r *webhooksRepository) FetchAll(ctx context.Context) ([]models.Webhook, error) {
+	const op = "postgresql.WebhooksRepository.FetchAll"
+
+	query := `
+	SELECT ` + webhookColumns + `
+	FROM game_creator.webhooks
+	ORDER BY created_at, webhook_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to get webhooks from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var webhooks []models.Webhook
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan webhook: %w", op, dbError(err))
+		}
+		webhooks = append(webhooks, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: Failed to get webhooks from db: %w", op, dbError(err))
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhooksRepository) Update(ctx context.Context, updated *models.Webhook) error {
+	const op = "postgresql.WebhooksRepository.Update"
+
+	query := `
+	UPDATE game_creator.webhooks
+	SET url = $1,
+	    secret = $2,
+	    event_types = $3,
+	    game_statuses = $4,
+	    tournament_id = $5,
+	    active = $6
+	WHERE webhook_id = $7
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		updated.URL,
+		updated.Secret,
+		pq.StringArray(updated.EventTypes),
+		gameStatusArray(updated.GameStatuses),
+		updated.TournamentID,
+		updated.Active,
+		updated.WebhookID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: failed to update webhook: %w", op, dbError(err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: failed to get rows affected: %w", op, dbError(err))
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, domain.NotFound("webhook", updated.WebhookID))
+	}
+
+	return nil
+}
+
+func (r *webhooksRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	const op = "postgresql.WebhooksRepository.DeleteById"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	DELETE FROM game_creator.webhooks WHERE webhook_id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, query, id)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: Failed to delete from webhooks: %w", op, dbError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+func (r *webhooksRepository) Enqueue(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	const op = "postgresql.WebhooksRepository.Enqueue"
+
+	tx, err := begin(ctx, r.db)
+	if err != nil {
+		return fmt.Errorf("%s: Failed to begin transaction: %w", op, dbError(err))
+	}
+
+	query := `
+	INSERT INTO game_creator.webhook_deliveries (delivery_id, webhook_id, sequence, event_type, payload)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (webhook_id, sequence) DO NOTHING
+	`
+
+	for _, d := range deliveries {
+		_, err = tx.ExecContext(ctx, query, d.DeliveryID, d.WebhookID, d.Sequence, d.EventType, string(d.Payload))
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%s: Failed to insert into webhook_deliveries: %w", op, dbError(err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: Failed to commit transaction: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+const deliveryColumns = `d.delivery_id, d.webhook_id, d.sequence, d.event_type, d.payload, d.status, d.attempts,
+	       d.last_error, d.response_status, d.created_at, d.next_attempt_at, d.delivered_at`
+
+func scanDelivery(row interface{ Scan(dest ...any) error }, extra ...any) (models.WebhookDelivery, error) {
+	var d models.WebhookDelivery
+	var deliveredAt sql.NullTime
+	err := row.Scan(append([]any{
+		&d.DeliveryID,
+		&d.WebhookID,
+		&d.Sequence,
+		&d.EventType,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.LastError,
+		&d.ResponseStatus,
+		&d.CreatedAt,
+		&d.NextAttemptAt,
+		&deliveredAt,
+	}, extra...)...)
+	d.DeliveredAt = deliveredAt.Time
+	return d, err
+}
+
+func (r *webhooksRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	const op = "postgresql.WebhooksRepository.ClaimDeliveries"
+
+	// SKIP LOCKED lets several senders claim disjoint deliveries at once.
+	query := `
+	UPDATE game_creator.webhook_deliveries d
+	SET next_attempt_at = now() + $2 * interval '1 millisecond'
+	FROM game_creator.webhooks w, (
+	    SELECT delivery_id
+	    FROM game_creator.webhook_deliveries
+	    WHERE status = 'pending' AND next_attempt_at <= now()
+	    ORDER BY next_attempt_at
+	    LIMIT $1
+	    FOR UPDATE SKIP LOCKED
+	) claimed
+	WHERE d.delivery_id = claimed.delivery_id AND w.webhook_id = d.webhook_id
+	RETURNING ` + deliveryColumns + `, w.url, w.secret
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("%s: Failed to claim webhook deliveries: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		var url, secret string
+		d, err := scanDelivery(rows, &url, &secret)
+		if err != nil {
+			return nil, fmt.Errorf("%s: Failed to scan webhook delivery: %w", op, dbError(err))
+		}
+		d.URL, d.Secret = url, secret
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	// Events reach a webhook in the order they happened as long as nothing
+	// fails.
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].Sequence < deliveries[j].Sequence })
+
+	return deliveries, nil
+}
+
+func (r *webhooksRepository) SaveAttempt(ctx context.Context, d *models.WebhookDelivery) error {
+	const op = "postgresql.WebhooksRepository.SaveAttempt"
+
+	query := `
+	UPDATE game_creator.webhook_deliveries
+	SET status = $1,
+	    attempts = $2,
+	    last_error = $3,
+	    response_status = $4,
+	    next_attempt_at = $5,
+	    delivered_at = $6
+	WHERE delivery_id = $7
+	`
+
+	deliveredAt := sql.NullTime{Time: d.DeliveredAt, Valid: !d.DeliveredAt.IsZero()}
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		d.Status,
+		d.Attempts,
+		d.LastError,
+		d.ResponseStatus,
+		d.NextAttemptAt,
+		deliveredAt,
+		d.DeliveryID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: failed to update webhook delivery: %w", op, dbError(err))
+	}
+
+	return nil
+}
+
+const deliveriesSort = "created_at"
+
+func (r *webhooksRepository) ListDeliveries(ctx context.Context, f models.DeliveriesFilter) (models.DeliveriesPage, error) {
+	const op = "postgresql.WebhooksRepository.ListDeliveries"
+
+	var p placeholders
+	conds := []string{"d.webhook_id = " + p.add(f.WebhookID)}
+	if f.Status != "" {
+		conds = append(conds, "d.status = "+p.add(f.Status))
+	}
+
+	// The latest deliveries come first.
+	if f.Page.Token != "" {
+		c, err := decodeCursor(f.Page.Token, deliveriesSort)
+		if err != nil {
+			return models.DeliveriesPage{}, fmt.Errorf("%s: %w", op, dbError(err))
+		}
+		key, err := time.Parse(time.RFC3339Nano, c.Key)
+		if err != nil {
+			return models.DeliveriesPage{}, fmt.Errorf("%s: %w", op, errMalformedToken)
+		}
+		conds = append(conds, fmt.Sprintf("(d.created_at, d.delivery_id) < (%s, %s)", p.add(key), p.add(c.ID)))
+	}
+
+	limit := f.Page.Limit()
+	query := `
+	SELECT ` + deliveryColumns + `
+	FROM game_creator.webhook_deliveries d
+	WHERE ` + strings.Join(conds, " AND ") + `
+	ORDER BY d.created_at DESC, d.delivery_id DESC
+	LIMIT ` + p.add(limit+1)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, p.args...)
+	if err != nil {
+		return models.DeliveriesPage{}, fmt.Errorf("%s: Failed to get webhook deliveries from db: %w", op, dbError(err))
+	}
+	defer rows.Close()
+
+	var page models.DeliveriesPage
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return models.DeliveriesPage{}, fmt.Errorf("%s: Failed to scan webhook delivery: %w", op, dbError(err))
+		}
+		page.Deliveries = append(page.Deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return models.DeliveriesPage{}, fmt.Errorf("%s: Failed to get webhook deliveries from db: %w", op, dbError(err))
+	}
+
+	if len(page.Deliveries) > limit {
+		page.Deliveries = page.Deliveries[:limit]
+		last := page.Deliveries[limit-1]
+		page.NextPageToken = encodeCursor(cursor{
+			Sort: deliveriesSort,
+			Key:  last.CreatedAt.Format(time.RFC3339Nano),
+			ID:   last.DeliveryID,
+		})
+	}
+
+	return page, nil
+}
+
+func (r *webhooksRepository) FetchDelivery(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error) {
+	const op = "postgresql.WebhooksRepository.FetchDelivery"
+
+	query := `
+	SELECT ` + deliveryColumns + `
+	FROM game_creator.webhook_deliveries d WHERE d.delivery_id = $1
+	`
+
+	d, err := scanDelivery(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.WebhookDelivery{}, fmt.Errorf("%s: %w", op, domain.NotFound("webhook delivery", id))
+		}
+		return models.WebhookDelivery{}, fmt.Errorf("%s: Failed to get webhook delivery from db: %w", op, dbError(err))
+	}
+
+	return d, nil
+}
+
+func (r *webhooksRepository) Redeliver(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error) {
+	const op = "postgresql.WebhooksRepository.Redeliver"
+
+	query := `
+	UPDATE game_creator.webhook_deliveries d
+	SET status = 'pending', attempts = 0, next_attempt_at = now()
+	WHERE d.delivery_id = $1 AND d.status = 'dead'
+	RETURNING ` + deliveryColumns
+
+	d, err := scanDelivery(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		var exists bool
+		err = conn(ctx, r.db).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM game_creator.webhook_deliveries WHERE delivery_id = $1)`, id).Scan(&exists)
+		if err != nil {
+			return models.WebhookDelivery{}, fmt.Errorf("%s: %w", op, dbError(err))
+		}
+		if !exists {
+			return models.WebhookDelivery{}, fmt.Errorf("%s: %w", op, domain.NotFound("webhook delivery", id))
+		}
+		return models.WebhookDelivery{}, fmt.Errorf("%s: %w", op, &domain.Error{
+			Kind:     domain.ErrConflict,
+			Reason:   "DELIVERY_NOT_DEAD",
+			Resource: "webhook delivery",
+			Message:  "only dead deliveries can be redelivered",
+		})
+	}
+	if err != nil {
+		return models.WebhookDelivery{}, fmt.Errorf("%s: failed to update webhook delivery: %w", op, dbError(err))
+	}
+
+	return d, nil
+}
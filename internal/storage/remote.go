@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"io"
+	"tournaments-core/internal/delivery/grpc/storage_grpc"
+	"tournaments-core/internal/domain"
+)
+
+// ChunkSize is the most content sent in a single stream message.
+const ChunkSize = 64 << 10
+
+// RemoteStore keeps blobs in the storage service.
+type RemoteStore struct {
+	conn   *grpc.ClientConn
+	client storage_grpc.StorageServiceClient
+}
+
+func NewRemoteStore(target string) (*RemoteStore, error) {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteStore{conn: conn, client: storage_grpc.NewStorageServiceClient(conn)}, nil
+}
+
+func (s *RemoteStore) Put(ctx context.Context, key string, r io.Reader) error {
+	// Cancelling the stream instead of closing it tells the storage service
+	// to drop what it got so far.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := s.client.Put(ctx)
+	if err != nil {
+		return storageError(err, key)
+	}
+	if err := stream.Send(&storage_grpc.PutRequest{Data: &storage_grpc.PutRequest_Key{Key: key}}); err != nil {
+		return storageError(err, key)
+	}
+
+	buf := make([]byte, ChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			chunk := &storage_grpc.PutRequest{Data: &storage_grpc.PutRequest_Chunk{Chunk: buf[:n]}}
+			if err := stream.Send(chunk); err != nil {
+				_, err = stream.CloseAndRecv()
+				return storageError(err, key)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := stream.CloseAndRecv(); err != nil {
+		return storageError(err, key)
+	}
+	return nil
+}
+
+// Get waits for the first chunk, so that missing blobs are reported here
+// rather than on the first read.
+func (s *RemoteStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := s.client.Get(ctx, &storage_grpc.GetRequest{Key: key})
+	if err != nil {
+		cancel()
+		return nil, storageError(err, key)
+	}
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		cancel()
+		return nil, storageError(err, key)
+	}
+
+	return &remoteBlob{stream: stream, key: key, buf: first.GetChunk(), done: err != nil, cancel: cancel}, nil
+}
+
+func (s *RemoteStore) Delete(ctx context.Context, key string) error {
+	_, err := s.client.Delete(ctx, &storage_grpc.DeleteRequest{Key: key})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return storageError(err, key)
+	}
+	return nil
+}
+
+func (s *RemoteStore) Close() error {
+	return s.conn.Close()
+}
+
+type remoteBlob struct {
+	stream storage_grpc.StorageService_GetClient
+	key    string
+	buf    []byte
+	done   bool
+	cancel context.CancelFunc
+}
+
+func (b *remoteBlob) Read(p []byte) (int, error) {
+	for len(b.buf) == 0 {
+		if b.done {
+			return 0, io.EOF
+		}
+		resp, err := b.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			b.done = true
+			continue
+		}
+		if err != nil {
+			return 0, storageError(err, b.key)
+		}
+		b.buf = resp.GetChunk()
+	}
+
+	n := copy(p, b.buf)
+	b.buf = b.buf[n:]
+	return n, nil
+}
+
+func (b *remoteBlob) Close() error {
+	b.cancel()
+	return nil
+}
+
+func storageError(err error, key string) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return domain.NotFound("blob", key)
+	case codes.Canceled, codes.DeadlineExceeded:
+		return err
+	}
+	return &domain.Error{
+		Kind:    domain.ErrUnavailable,
+		Reason:  "STORAGE_UNAVAILABLE",
+		Message: "storage service is unavailable",
+		Err:     err,
+	}
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"slices"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+var (
+	organiserAccess = []models.GrantAccess{models.AccessOwner, models.AccessCoOrganiser}
+	officialAccess  = []models.GrantAccess{models.AccessOwner, models.AccessCoOrganiser, models.AccessReferee}
+)
+
+// tournamentAccess checks the caller of a request against the grants of a
+// tournament. Work without a caller is done by the service itself and admins
+// manage every tournament, neither is checked.
+type tournamentAccess struct {
+	grants repository.TournamentGrantsRepository
+}
+
+// check returns the caller's grant on the tournament, a zero grant when the
+// caller is not checked. Games outside of a tournament are open to everyone
+// the RPC roles let through.
+func (a tournamentAccess) check(ctx context.Context, tournamentId uuid.NullUUID, allowed ...models.GrantAccess) (models.TournamentGrant, error) {
+	principal, ok := domain.PrincipalFrom(ctx)
+	if !ok || principal.HasRole(models.RoleAdmin) || !tournamentId.Valid {
+		return models.TournamentGrant{}, nil
+	}
+
+	grant, err := a.grants.Fetch(ctx, tournamentId.UUID, principal.Subject)
+	if errors.Is(err, domain.ErrNotFound) {
+		return models.TournamentGrant{}, domain.PermissionDenied("%s has no access to tournament %s", principal.Subject, tournamentId.UUID)
+	}
+	if err != nil {
+		return models.TournamentGrant{}, err
+	}
+	if !slices.Contains(allowed, grant.Access) {
+		return models.TournamentGrant{}, domain.PermissionDenied("%s access to tournament %s does not allow this", grant.Access, tournamentId.UUID)
+	}
+	return grant, nil
+}
+
+func tournamentOf(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: true}
+}
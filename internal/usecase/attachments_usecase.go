@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/google/uuid"
+	"hash"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+	"unicode/utf8"
+)
+
+const (
+	MaxAttachmentSize = 64 << 20
+	maxAttachmentName = 255
+)
+
+type attachmentsUseCase struct {
+	attachmentsRepository repository.AttachmentsRepository
+	resultsRepository     repository.ResultsRepository
+	gamesRepository       repository.GamesRepository
+	reviewsRepository     repository.ResultReviewsRepository
+	blobs                 repository.BlobStore
+	access                tournamentAccess
+	contextTimeout        time.Duration
+}
+
+func NewAttachmentsUseCase(a repository.AttachmentsRepository, r repository.ResultsRepository, g repository.GamesRepository, reviews repository.ResultReviewsRepository, grants repository.TournamentGrantsRepository, blobs repository.BlobStore, timeout time.Duration) usecase.AttachmentsUseCase {
+	return &attachmentsUseCase{
+		attachmentsRepository: a,
+		resultsRepository:     r,
+		gamesRepository:       g,
+		reviewsRepository:     reviews,
+		blobs:                 blobs,
+		access:                tournamentAccess{grants},
+		contextTimeout:        timeout,
+	}
+}
+
+func (au *attachmentsUseCase) FetchByResult(ctx context.Context, resultId uuid.UUID) ([]models.Attachment, error) {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	if _, err := au.resultsRepository.FetchById(ctx, resultId); err != nil {
+		return nil, err
+	}
+	return au.attachmentsRepository.FetchByResult(ctx, resultId)
+}
+
+// Upload only holds the use case timeout while checking and recording the
+// attachment, the file takes as long as the client needs to send it.
+func (au *attachmentsUseCase) Upload(ctx context.Context, a *models.Attachment, r io.Reader) error {
+	if err := validateAttachment(a); err != nil {
+		return err
+	}
+	if err := au.checkOwner(ctx, *a, officialAccess...); err != nil {
+		return err
+	}
+
+	a.AttachmentID = uuid.New()
+	if a.ReportID.Valid {
+		a.StorageKey = "reports/" + a.ReportID.UUID.String() + "/" + a.AttachmentID.String()
+	} else {
+		a.StorageKey = "results/" + a.ResultID.UUID.String() + "/" + a.AttachmentID.String()
+	}
+	a.UploadedBy = subjectOf(ctx)
+
+	content := &attachmentReader{r: r, hash: sha256.New()}
+	if err := au.blobs.Put(ctx, a.StorageKey, content); err != nil {
+		return err
+	}
+	if content.size == 0 {
+		au.blobs.Delete(ctx, a.StorageKey)
+		return domain.Invalid(domain.FieldViolation{Field: "content", Description: "must not be empty"})
+	}
+	a.Size = content.size
+	a.SHA256 = hex.EncodeToString(content.hash.Sum(nil))
+
+	createCtx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+	if err := au.attachmentsRepository.Create(createCtx, a); err != nil {
+		au.blobs.Delete(ctx, a.StorageKey)
+		return err
+	}
+	return nil
+}
+
+func (au *attachmentsUseCase) AddLink(ctx context.Context, a *models.Attachment) error {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	a.Kind = models.AttachmentDemoLink
+	if err := validateAttachment(a); err != nil {
+		return err
+	}
+	if err := au.checkOwner(ctx, *a, officialAccess...); err != nil {
+		return err
+	}
+
+	a.AttachmentID = uuid.New()
+	a.ContentType, a.Size, a.SHA256, a.StorageKey = "", 0, "", ""
+	a.UploadedBy = subjectOf(ctx)
+	return au.attachmentsRepository.Create(ctx, a)
+}
+
+func (au *attachmentsUseCase) Download(ctx context.Context, id uuid.UUID) (models.Attachment, io.ReadCloser, error) {
+	fetchCtx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	a, err := au.attachmentsRepository.FetchById(fetchCtx, id)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	if !a.HasContent() {
+		return models.Attachment{}, nil, domain.Conflict("attachment %s is a link and has no content", id)
+	}
+
+	content, err := au.blobs.Get(ctx, a.StorageKey)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	return a, content, nil
+}
+
+// DeleteById removes the attachment before its file, a file left behind
+// when the store fails is only wasted space.
+func (au *attachmentsUseCase) DeleteById(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	a, err := au.attachmentsRepository.FetchById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := au.checkOwner(ctx, a, organiserAccess...); err != nil {
+		return err
+	}
+	if err := au.attachmentsRepository.DeleteById(ctx, id); err != nil {
+		return err
+	}
+	if a.HasContent() {
+		au.blobs.Delete(ctx, a.StorageKey)
+	}
+	return nil
+}
+
+// checkOwner makes sure the result or report of an attachment exists and the
+// caller may attach evidence to it: officials with access to the tournament
+// of the game, and whoever filed a report while its review is open.
+func (au *attachmentsUseCase) checkOwner(ctx context.Context, a models.Attachment, allowed ...models.GrantAccess) error {
+	ctx, cancel := context.WithTimeout(ctx, au.contextTimeout)
+	defer cancel()
+
+	if !a.ReportID.Valid {
+		result, err := au.resultsRepository.FetchById(ctx, a.ResultID.UUID)
+		if err != nil {
+			return err
+		}
+		return au.checkOfficial(ctx, result.GameID, allowed...)
+	}
+
+	report, err := au.reviewsRepository.FetchReport(ctx, a.ReportID.UUID)
+	if err != nil {
+		return err
+	}
+	if principal, ok := domain.PrincipalFrom(ctx); ok && report.Subject != "" && principal.Subject == report.Subject {
+		review, err := au.reviewsRepository.FetchByGame(ctx, report.GameID)
+		if err != nil {
+			return err
+		}
+		if review.Status.Final() {
+			return reviewClosed(review)
+		}
+		return nil
+	}
+	return au.checkOfficial(ctx, report.GameID, allowed...)
+}
+
+func (au *attachmentsUseCase) checkOfficial(ctx context.Context, gameId uuid.UUID, allowed ...models.GrantAccess) error {
+	if principal, ok := domain.PrincipalFrom(ctx); ok && !principal.HasAnyRole(models.RoleReferee, models.RoleOrganiser) {
+		return domain.PermissionDenied("%s cannot attach evidence to game %s", principal.Subject, gameId)
+	}
+
+	game, err := au.gamesRepository.FetchById(ctx, gameId)
+	if err != nil {
+		return err
+	}
+	_, err = au.access.check(ctx, game.TournamentID, allowed...)
+	return err
+}
+
+// validateAttachment accepts screenshots of any image type, replays of any
+// type and demo links to http or https URLs.
+func validateAttachment(a *models.Attachment) error {
+	var v validator
+	v.check(a.ResultID.Valid != a.ReportID.Valid, "result_id", "exactly one of result_id and report_id is required")
+	v.check(utf8.RuneCountInString(a.Name) <= maxAttachmentName, "name", "must be at most %d characters", maxAttachmentName)
+
+	switch a.Kind {
+	case models.AttachmentScreenshot:
+		v.check(strings.HasPrefix(a.ContentType, "image/"), "content_type", "must be an image type for a screenshot")
+		v.check(a.Name != "", "name", "is required")
+	case models.AttachmentReplay:
+		v.check(a.Name != "", "name", "is required")
+	case models.AttachmentDemoLink:
+		u, err := url.Parse(a.URL)
+		v.check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "",
+			"url", "must be an absolute http or https URL")
+	default:
+		v.check(false, "kind", "must be one of %v", models.AttachmentKinds)
+	}
+	if a.Kind != models.AttachmentDemoLink {
+		v.check(a.URL == "", "url", "is only set for demo links")
+	}
+
+	return v.err()
+}
+
+// attachmentReader hashes and counts an uploaded file and fails once it
+// grows past MaxAttachmentSize.
+type attachmentReader struct {
+	r    io.Reader
+	hash hash.Hash
+	size int64
+}
+
+func (r *attachmentReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.hash.Write(p[:n])
+	r.size += int64(n)
+	if r.size > MaxAttachmentSize {
+		return n, domain.Invalid(domain.FieldViolation{
+			Field:       "content",
+			Description: fmt.Sprintf("must be at most %d bytes", MaxAttachmentSize),
+		})
+	}
+	return n, err
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// SingleEliminationBracket lays out every game of a single-elimination
+// bracket for seeds given from strongest to weakest. The field is padded to
+// the next power of two and the top seeds receive byes: they are placed
+// straight into their second round game instead of playing in round one.
+// Rounds start SlotLength apart from the tournament start.
+func SingleEliminationBracket(t models.Tournament, seeds []uuid.UUID) ([]models.Game, error) {
+	if len(seeds) < 2 {
+		return nil, domain.InvalidArgument("bracket needs at least 2 participants, got %d", len(seeds))
+	}
+
+	size, rounds := 1, 0
+	for size < len(seeds) {
+		size *= 2
+		rounds++
+	}
+
+	order := seedOrder(size)
+
+	games := make([][]models.Game, rounds+1)
+	for round := 1; round <= rounds; round++ {
+		games[round] = make([]models.Game, size>>round)
+		for position := range games[round] {
+			games[round][position] = newBracketGame(t, models.BracketWinners, round, position)
+			games[round][position].GameStart = t.StartsAt.Add(time.Duration(round-1) * t.SlotLength)
+		}
+	}
+
+	for position := range games[1] {
+		top, bottom := order[2*position], order[2*position+1]
+		if bottom > len(seeds) {
+			// The opponent does not exist, so the seed skips round one.
+			next := &games[2][position/2]
+			next.Participants = append(next.Participants, models.GameParticipant{
+				ParticipantID: seeds[top-1],
+				Slot:          position % 2,
+			})
+			continue
+		}
+		games[1][position].Participants = []models.GameParticipant{
+			{ParticipantID: seeds[top-1], Slot: 0},
+			{ParticipantID: seeds[bottom-1], Slot: 1},
+		}
+	}
+
+	var bracket []models.Game
+	for round := 1; round <= rounds; round++ {
+		for _, g := range games[round] {
+			if round == 1 && len(g.Participants) == 0 {
+				continue
+			}
+			bracket = append(bracket, g)
+		}
+	}
+
+	return bracket, nil
+}
+
+// seedOrder returns 1-based seeds in bracket order so that seed 1 and seed 2
+// can only meet in the final: 1,2 -> 1,4,2,3 -> 1,8,4,5,2,7,3,6 ...
+func seedOrder(size int) []int {
+	order := []int{1}
+	for n := 2; n <= size; n *= 2 {
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+func newBracketGame(t models.Tournament, bracket models.BracketSide, round, position int) models.Game {
+	return models.Game{
+		GameID:       uuid.New(),
+		GameStart:    t.StartsAt,
+		GameTypeID:   t.GameTypeID,
+		TournamentID: uuid.NullUUID{UUID: t.TournamentID, Valid: true},
+		Bracket:      bracket,
+		Round:        round,
+		Position:     position,
+	}
+}
+
+// nextBracketSlot tells where the winner of a single-elimination game plays next.
+func nextBracketSlot(g models.Game) (round, position, slot int) {
+	return g.Round + 1, g.Position / 2, g.Position % 2
+}
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"testing"
+	"time"
+	"tournaments-core/internal/domain/models"
+)
+
+func testTournament(format models.TournamentFormat) models.Tournament {
+	return models.Tournament{
+		TournamentID: uuid.New(),
+		Format:       format,
+		StartsAt:     time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+		SlotLength:   time.Hour,
+	}
+}
+
+// findGame returns the game of the bracket at round and position.
+func findGame(t *testing.T, games []models.Game, bracket models.BracketSide, round, position int) models.Game {
+	t.Helper()
+	for _, g := range games {
+		if g.Bracket == bracket && g.Round == round && g.Position == position {
+			return g
+		}
+	}
+	t.Fatalf("no %s game at round %d, position %d", bracket, round, position)
+	return models.Game{}
+}
+
+func TestSingleEliminationBracket(t *testing.T) {
+	tests := []struct {
+		seeds int
+		// round1 is how many games are played in round one, the other seeds
+		// get a bye into round two.
+		round1 int
+		rounds int
+	}{
+		{seeds: 2, round1: 1, rounds: 1},
+		{seeds: 3, round1: 1, rounds: 2},
+		{seeds: 4, round1: 2, rounds: 2},
+		{seeds: 5, round1: 1, rounds: 3},
+		{seeds: 6, round1: 2, rounds: 3},
+		{seeds: 7, round1: 3, rounds: 3},
+		{seeds: 9, round1: 1, rounds: 4},
+	}
+
+	for _, tt := range tests {
+		tournament := testTournament(models.FormatSingleElimination)
+		seeds := testSeeds(tt.seeds)
+		games, err := SingleEliminationBracket(tournament, seeds)
+		if err != nil {
+			t.Fatalf("%d seeds: SingleEliminationBracket() error = %v", tt.seeds, err)
+		}
+
+		// Every seed but the champion is knocked out in exactly one game.
+		if len(games) != tt.seeds-1 {
+			t.Errorf("%d seeds: got %d games, want %d", tt.seeds, len(games), tt.seeds-1)
+		}
+
+		placed := make(map[uuid.UUID]int)
+		round1, lastRound := 0, 0
+		for _, g := range games {
+			if len(g.Participants) > 2 {
+				t.Errorf("%d seeds: game %d/%d has %d participants", tt.seeds, g.Round, g.Position, len(g.Participants))
+			}
+			for _, p := range g.Participants {
+				placed[p.ParticipantID]++
+			}
+			if g.Round == 1 {
+				round1++
+			}
+			lastRound = max(lastRound, g.Round)
+
+			wantStart := tournament.StartsAt.Add(time.Duration(g.Round-1) * time.Hour)
+			if !g.GameStart.Equal(wantStart) {
+				t.Errorf("%d seeds: game %d/%d starts at %v, want %v", tt.seeds, g.Round, g.Position, g.GameStart, wantStart)
+			}
+		}
+		if round1 != tt.round1 || lastRound != tt.rounds {
+			t.Errorf("%d seeds: got %d games in round 1 and %d rounds, want %d and %d", tt.seeds, round1, lastRound, tt.round1, tt.rounds)
+		}
+
+		// The strongest seeds get the byes and start in round two.
+		byes := tt.seeds - 2*tt.round1
+		for i, seed := range seeds {
+			if placed[seed] != 1 {
+				t.Errorf("%d seeds: seed %d is placed %d times, want once", tt.seeds, i+1, placed[seed])
+			}
+			for _, g := range games {
+				for _, p := range g.Participants {
+					if p.ParticipantID == seed && (g.Round == 1) != (i >= byes) {
+						t.Errorf("%d seeds: seed %d starts in round %d", tt.seeds, i+1, g.Round)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSingleEliminationBracketKeepsTopSeedsApart(t *testing.T) {
+	seeds := testSeeds(8)
+	games, err := SingleEliminationBracket(testTournament(models.FormatSingleElimination), seeds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][2]uuid.UUID{
+		{seeds[0], seeds[7]}, {seeds[3], seeds[4]}, {seeds[1], seeds[6]}, {seeds[2], seeds[5]},
+	}
+	for position, pair := range want {
+		if got := pairing(findGame(t, games, models.BracketWinners, 1, position)); got != pair {
+			t.Errorf("round 1 game %d pairs %v, want %v", position, got, pair)
+		}
+	}
+}
+
+func TestDoubleEliminationBracketRoutesLosers(t *testing.T) {
+	type route struct {
+		bracket  models.BracketSide
+		round    int
+		position int
+		slot     int
+	}
+	type game struct {
+		bracket  models.BracketSide
+		round    int
+		position int
+		// winner and loser are where the game sends them, the zero route
+		// for none.
+		winner route
+		loser  route
+		// start is the slot the game starts in.
+		start int
+	}
+
+	tests := []struct {
+		name  string
+		seeds int
+		games []game
+	}{
+		{
+			name:  "two seeds meet again in the grand final",
+			seeds: 2,
+			games: []game{
+				{bracket: models.BracketWinners, round: 1,
+					winner: route{models.BracketGrandFinal, 1, 0, 0},
+					loser:  route{models.BracketGrandFinal, 1, 0, 1}},
+				{bracket: models.BracketGrandFinal, round: 1, start: 1},
+			},
+		},
+		{
+			name:  "four seeds",
+			seeds: 4,
+			games: []game{
+				{bracket: models.BracketWinners, round: 1, position: 0,
+					winner: route{models.BracketWinners, 2, 0, 0},
+					loser:  route{models.BracketLosers, 1, 0, 0}},
+				{bracket: models.BracketWinners, round: 1, position: 1,
+					winner: route{models.BracketWinners, 2, 0, 1},
+					loser:  route{models.BracketLosers, 1, 0, 1}},
+				{bracket: models.BracketWinners, round: 2, start: 1,
+					winner: route{models.BracketGrandFinal, 1, 0, 0},
+					loser:  route{models.BracketLosers, 2, 0, 1}},
+				{bracket: models.BracketLosers, round: 1, start: 1,
+					winner: route{models.BracketLosers, 2, 0, 0}},
+				{bracket: models.BracketLosers, round: 2, start: 2,
+					winner: route{models.BracketGrandFinal, 1, 0, 1}},
+				{bracket: models.BracketGrandFinal, round: 1, start: 3},
+			},
+		},
+		{
+			// Seed 1 has a bye, so only one loser drops from round one and
+			// the losers bracket game it would have played is skipped.
+			name:  "three seeds skip the empty losers game",
+			seeds: 3,
+			games: []game{
+				{bracket: models.BracketWinners, round: 1, position: 1,
+					winner: route{models.BracketWinners, 2, 0, 1},
+					loser:  route{models.BracketLosers, 2, 0, 0}},
+				{bracket: models.BracketWinners, round: 2, start: 1,
+					winner: route{models.BracketGrandFinal, 1, 0, 0},
+					loser:  route{models.BracketLosers, 2, 0, 1}},
+				{bracket: models.BracketLosers, round: 2, start: 2,
+					winner: route{models.BracketGrandFinal, 1, 0, 1}},
+				{bracket: models.BracketGrandFinal, round: 1, start: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tournament := testTournament(models.FormatDoubleElimination)
+			games, err := DoubleEliminationBracket(tournament, testSeeds(tt.seeds))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(games) != len(tt.games) {
+				t.Fatalf("got %d games, want %d", len(games), len(tt.games))
+			}
+
+			next := func(r route) models.NextSlot {
+				if r.bracket == "" {
+					return models.NextSlot{}
+				}
+				g := findGame(t, games, r.bracket, r.round, r.position)
+				return models.NextSlot{GameID: uuid.NullUUID{UUID: g.GameID, Valid: true}, Slot: r.slot}
+			}
+			for _, want := range tt.games {
+				g := findGame(t, games, want.bracket, want.round, want.position)
+				if got := next(want.winner); g.WinnerNext != got {
+					t.Errorf("%s %d/%d sends its winner to %+v, want %+v", g.Bracket, g.Round, g.Position, g.WinnerNext, got)
+				}
+				if got := next(want.loser); g.LoserNext != got {
+					t.Errorf("%s %d/%d sends its loser to %+v, want %+v", g.Bracket, g.Round, g.Position, g.LoserNext, got)
+				}
+				if start := tournament.StartsAt.Add(time.Duration(want.start) * time.Hour); !g.GameStart.Equal(start) {
+					t.Errorf("%s %d/%d starts at %v, want %v", g.Bracket, g.Round, g.Position, g.GameStart, start)
+				}
+			}
+		})
+	}
+}
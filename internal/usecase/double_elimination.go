@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// bracketNode is a game of a bracket under construction. Every slot is fed
+// either by a seeded participant or by the winner or loser of another node.
+type bracketNode struct {
+	game     models.Game
+	slots    [2]bracketFeed
+	winnerTo *bracketEdge
+	loserTo  *bracketEdge
+	// played is set once the node is known to have two entrants.
+	played bool
+	// decided is set once the node is known to produce a winner.
+	decided bool
+}
+
+type bracketFeed struct {
+	participant uuid.NullUUID
+	from        *bracketNode
+	loser       bool
+}
+
+type bracketEdge struct {
+	to   *bracketNode
+	slot int
+}
+
+func (n *bracketNode) sendWinner(to *bracketNode, slot int) {
+	n.winnerTo = &bracketEdge{to: to, slot: slot}
+	to.slots[slot] = bracketFeed{from: n}
+}
+
+func (n *bracketNode) sendLoser(to *bracketNode, slot int) {
+	n.loserTo = &bracketEdge{to: to, slot: slot}
+	to.slots[slot] = bracketFeed{from: n, loser: true}
+}
+
+func (f bracketFeed) live() bool {
+	if f.participant.Valid {
+		return true
+	}
+	if f.from == nil {
+		return false
+	}
+	if f.loser {
+		return f.from.played
+	}
+	return f.from.decided
+}
+
+// DoubleEliminationBracket lays out a double-elimination bracket for seeds
+// given from strongest to weakest. Losers of winners bracket games drop into
+// the losers bracket, the two bracket winners meet in the grand final. Every
+// game carries the slots its winner and loser move into; games left with a
+// single entrant because of byes are skipped and their entrant is routed
+// straight to the following game. Games are spaced SlotLength apart: losers
+// bracket round r is played alongside winners bracket round r+1, the grand
+// final follows the losers bracket final.
+func DoubleEliminationBracket(t models.Tournament, seeds []uuid.UUID) ([]models.Game, error) {
+	if len(seeds) < 2 {
+		return nil, domain.InvalidArgument("bracket needs at least 2 participants, got %d", len(seeds))
+	}
+
+	size, rounds := 1, 0
+	for size < len(seeds) {
+		size *= 2
+		rounds++
+	}
+
+	var ordered []*bracketNode
+	newRound := func(bracket models.BracketSide, round, count int) []*bracketNode {
+		slot := round - 1
+		switch bracket {
+		case models.BracketLosers:
+			slot = round
+		case models.BracketGrandFinal:
+			slot = 2*(rounds-1) + round
+		}
+		nodes := make([]*bracketNode, count)
+		for position := range nodes {
+			nodes[position] = &bracketNode{game: newBracketGame(t, bracket, round, position)}
+			nodes[position].game.GameStart = t.StartsAt.Add(time.Duration(slot) * t.SlotLength)
+		}
+		ordered = append(ordered, nodes...)
+		return nodes
+	}
+
+	winners := make([][]*bracketNode, rounds+1)
+	for round := 1; round <= rounds; round++ {
+		winners[round] = newRound(models.BracketWinners, round, size>>round)
+		if round > 1 {
+			for position, n := range winners[round-1] {
+				n.sendWinner(winners[round][position/2], position%2)
+			}
+		}
+	}
+
+	// The losers bracket alternates between rounds where its own survivors
+	// play each other and rounds where they meet the next batch of losers
+	// dropping from the winners bracket.
+	var losersFinal *bracketNode
+	var previous []*bracketNode
+	for round := 1; round <= 2*(rounds-1); round++ {
+		if round == 1 {
+			current := newRound(models.BracketLosers, round, size/4)
+			for position, n := range winners[1] {
+				n.sendLoser(current[position/2], position%2)
+			}
+			previous = current
+			continue
+		}
+
+		if round%2 == 0 {
+			dropping := winners[round/2+1]
+			current := newRound(models.BracketLosers, round, len(dropping))
+			for position, n := range previous {
+				n.sendWinner(current[position], 0)
+			}
+			for position, n := range dropping {
+				n.sendLoser(current[position], 1)
+			}
+			previous = current
+			continue
+		}
+
+		current := newRound(models.BracketLosers, round, len(previous)/2)
+		for position, n := range previous {
+			n.sendWinner(current[position/2], position%2)
+		}
+		previous = current
+	}
+	if len(previous) == 1 {
+		losersFinal = previous[0]
+	}
+
+	grandFinal := newRound(models.BracketGrandFinal, 1, 1)[0]
+	winners[rounds][0].sendWinner(grandFinal, 0)
+	if losersFinal != nil {
+		losersFinal.sendWinner(grandFinal, 1)
+	} else {
+		winners[rounds][0].sendLoser(grandFinal, 1)
+	}
+
+	order := seedOrder(size)
+	for position, n := range winners[1] {
+		for slot := 0; slot < 2; slot++ {
+			if seed := order[2*position+slot]; seed <= len(seeds) {
+				n.slots[slot] = bracketFeed{participant: uuid.NullUUID{UUID: seeds[seed-1], Valid: true}}
+			}
+		}
+	}
+
+	var kept []*bracketNode
+	for _, n := range ordered {
+		var entrants []int
+		for slot, feed := range n.slots {
+			if feed.live() {
+				entrants = append(entrants, slot)
+			}
+		}
+
+		switch {
+		case len(entrants) == 2 || n.winnerTo == nil:
+			n.played, n.decided = true, true
+			kept = append(kept, n)
+		case len(entrants) == 1:
+			n.decided = true
+			skipBracketNode(n, n.slots[entrants[0]])
+		}
+	}
+
+	// Links are resolved only now because skipped nodes rewire their feeders.
+	bracket := make([]models.Game, 0, len(kept))
+	for _, n := range kept {
+		for slot, feed := range n.slots {
+			if feed.participant.Valid {
+				n.game.Participants = append(n.game.Participants, models.GameParticipant{
+					ParticipantID: feed.participant.UUID,
+					Slot:          slot,
+				})
+			}
+		}
+		if n.winnerTo != nil {
+			n.game.WinnerNext = nextSlot(n.winnerTo)
+		}
+		if n.loserTo != nil {
+			n.game.LoserNext = nextSlot(n.loserTo)
+		}
+		bracket = append(bracket, n.game)
+	}
+
+	return bracket, nil
+}
+
+// skipBracketNode hands the only entrant of a node over to the node its
+// winner would have played in next.
+func skipBracketNode(n *bracketNode, feed bracketFeed) {
+	target := n.winnerTo
+	target.to.slots[target.slot] = feed
+	if feed.from == nil {
+		return
+	}
+	if feed.loser {
+		feed.from.loserTo = target
+	} else {
+		feed.from.winnerTo = target
+	}
+}
+
+func nextSlot(e *bracketEdge) models.NextSlot {
+	return models.NextSlot{
+		GameID: uuid.NullUUID{UUID: e.to.game.GameID, Valid: true},
+		Slot:   e.slot,
+	}
+}
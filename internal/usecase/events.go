@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+const (
+	// subscriberBuffer is how many events a watcher may lag behind before it
+	// is dropped and has to resume from its last sequence.
+	subscriberBuffer = 256
+	// followInterval is how often the broker looks for new outbox events.
+	followInterval = 200 * time.Millisecond
+	followBatch    = 500
+	// gapTimeout is how long the broker waits for a missing sequence before
+	// moving past it. Sequences are taken when an event is written and show
+	// up once its transaction commits, so a gap is a transaction that is
+	// still running or one that rolled back.
+	gapTimeout = 10 * time.Second
+)
+
+type subscriber struct {
+	filter models.EventFilter
+	events chan models.Event
+}
+
+// EventBroker follows the outbox and fans its events out to watchers. Events
+// are numbered by their outbox sequence, so watchers resume across restarts
+// of the service: the latest events are kept in memory and older ones are
+// read back from the outbox.
+type EventBroker struct {
+	outbox      repository.OutboxRepository
+	mu          sync.Mutex
+	sequence    uint64
+	history     []models.Event
+	capacity    int
+	subscribers map[*subscriber]struct{}
+	// gapSince is when the broker started waiting for a missing sequence.
+	gapSince time.Time
+}
+
+// NewEventBroker starts after the latest event in the outbox.
+func NewEventBroker(ctx context.Context, outbox repository.OutboxRepository, capacity int) (*EventBroker, error) {
+	last, err := outbox.Last(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &EventBroker{
+		outbox:      outbox,
+		sequence:    last,
+		capacity:    capacity,
+		subscribers: make(map[*subscriber]struct{}),
+	}, nil
+}
+
+// Run follows the outbox until ctx is done.
+func (b *EventBroker) Run(ctx context.Context) {
+	for {
+		n, err := b.follow(ctx)
+		if err != nil {
+			log.Printf("[EVENTS]: %v", err)
+		}
+
+		// A full batch means more events are likely waiting.
+		if err == nil && n == followBatch {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(followInterval):
+		}
+	}
+}
+
+// follow publishes the events written since the last one in sequence order.
+// It stops at a gap until the gap is filled or timed out.
+func (b *EventBroker) follow(ctx context.Context) (int, error) {
+	events, err := b.outbox.Since(ctx, b.last(), followBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, e := range events {
+		if e.Sequence != b.last()+1 {
+			if b.gapSince.IsZero() {
+				b.gapSince = time.Now()
+			}
+			if time.Since(b.gapSince) < gapTimeout {
+				return i, nil
+			}
+		}
+		b.gapSince = time.Time{}
+		b.publish(e)
+	}
+
+	return len(events), nil
+}
+
+func (b *EventBroker) last() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.sequence
+}
+
+func (b *EventBroker) publish(e models.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sequence = e.Sequence
+	b.history = append(b.history, e)
+	if len(b.history) > b.capacity {
+		b.history = b.history[len(b.history)-b.capacity:]
+	}
+
+	for s := range b.subscribers {
+		if !s.filter.Match(e) {
+			continue
+		}
+		select {
+		case s.events <- e:
+		default:
+			// The watcher fell behind, closing its channel tells it to
+			// resume from the last event it sent.
+			close(s.events)
+			delete(b.subscribers, s)
+		}
+	}
+}
+
+func (b *EventBroker) Watch(ctx context.Context, filter models.EventFilter, after uint64, send func(models.Event) error) error {
+	backlog, kept, s, err := b.subscribe(filter, after)
+	if err != nil {
+		return err
+	}
+	defer b.unsubscribe(s)
+
+	last := after
+	if after > 0 {
+		last, err = b.replay(ctx, filter, after, kept, send)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, e := range backlog {
+		if err := send(e); err != nil {
+			return err
+		}
+		last = e.Sequence
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-s.events:
+			if !ok {
+				return &domain.Error{
+					Kind:    domain.ErrUnavailable,
+					Reason:  "WATCHER_TOO_SLOW",
+					Message: "watcher fell behind, resume after sequence " + formatSequence(last),
+				}
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+			last = e.Sequence
+		}
+	}
+}
+
+// subscribe registers a watcher and returns the kept events after the
+// sequence under the same lock, so that no event is missed or sent twice.
+// kept is the first sequence held in memory, older ones are in the outbox.
+func (b *EventBroker) subscribe(filter models.EventFilter, after uint64) ([]models.Event, uint64, *subscriber, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if after > b.sequence {
+		return nil, 0, nil, domain.InvalidArgument("sequence %d has not been published yet", after)
+	}
+
+	kept := b.sequence + 1
+	if len(b.history) > 0 {
+		kept = b.history[0].Sequence
+	}
+
+	var backlog []models.Event
+	if after > 0 {
+		for _, e := range b.history {
+			if e.Sequence > after && filter.Match(e) {
+				backlog = append(backlog, e)
+			}
+		}
+	}
+
+	s := &subscriber{filter: filter, events: make(chan models.Event, subscriberBuffer)}
+	b.subscribers[s] = struct{}{}
+	return backlog, kept, s, nil
+}
+
+// replay sends the events after the sequence that are no longer kept in
+// memory, reading them back from the outbox. It returns the last sequence
+// sent.
+func (b *EventBroker) replay(ctx context.Context, filter models.EventFilter, after, kept uint64, send func(models.Event) error) (uint64, error) {
+	last := after
+	for last+1 < kept {
+		events, err := b.outbox.Since(ctx, last, followBatch)
+		if err != nil {
+			return last, err
+		}
+
+		for _, e := range events {
+			if e.Sequence >= kept {
+				return last, nil
+			}
+			if filter.Match(e) {
+				if err := send(e); err != nil {
+					return last, err
+				}
+			}
+			last = e.Sequence
+		}
+		if len(events) < followBatch {
+			break
+		}
+	}
+	return last, nil
+}
+
+func (b *EventBroker) unsubscribe(s *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscribers, s)
+}
+
+func formatSequence(sequence uint64) string {
+	return strconv.FormatUint(sequence, 10)
+}
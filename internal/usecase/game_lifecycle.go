@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// gameTransitions lists the statuses a game may move to from each status.
+// Finished and cancelled games are final.
+var gameTransitions = map[models.GameStatus][]models.GameStatus{
+	models.GameScheduled: {models.GameCheckIn, models.GameLive, models.GamePostponed, models.GameCancelled},
+	models.GameCheckIn:   {models.GameLive, models.GamePostponed, models.GameCancelled},
+	models.GameLive:      {models.GameFinished, models.GameCancelled},
+	models.GamePostponed: {models.GameScheduled, models.GameCancelled},
+}
+
+// transitionAccess is the access to a tournament needed to move its games to
+// a status, referees open check-in and start games but only organisers
+// cancel or postpone them.
+var transitionAccess = map[models.GameStatus][]models.GrantAccess{
+	models.GameCheckIn:   officialAccess,
+	models.GameLive:      officialAccess,
+	models.GamePostponed: organiserAccess,
+	models.GameCancelled: organiserAccess,
+}
+
+func canTransition(from, to models.GameStatus) bool {
+	for _, s := range gameTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+func transitionError(g models.Game, to models.GameStatus) error {
+	return &domain.Error{
+		Kind:     domain.ErrConflict,
+		Reason:   "INVALID_GAME_TRANSITION",
+		Resource: "game",
+		Message:  "game " + g.GameID.String() + " cannot move from " + string(g.Status) + " to " + string(to),
+	}
+}
+
+// reschedule moves the start of a game that has not begun yet, a postponed
+// game is scheduled again.
+func reschedule(g *models.Game, start time.Time) error {
+	switch g.Status {
+	case models.GameScheduled, models.GameCheckIn:
+	case models.GamePostponed:
+		g.Status = models.GameScheduled
+	default:
+		return domain.Conflict("a %s game cannot be rescheduled", g.Status)
+	}
+	g.GameStart = start
+	return nil
+}
+
+func (gu *gamesUseCase) OpenCheckIn(ctx context.Context, id uuid.UUID, version int64) (models.Game, error) {
+	return gu.transition(ctx, id, version, models.GameCheckIn, nil)
+}
+
+func (gu *gamesUseCase) Start(ctx context.Context, id uuid.UUID, version int64) (models.Game, error) {
+	return gu.transition(ctx, id, version, models.GameLive, nil)
+}
+
+func (gu *gamesUseCase) Cancel(ctx context.Context, id uuid.UUID, version int64) (models.Game, error) {
+	return gu.transition(ctx, id, version, models.GameCancelled, nil)
+}
+
+// Postpone puts a game off, a non-zero start is recorded as the new start
+// while the game stays postponed until it is rescheduled.
+func (gu *gamesUseCase) Postpone(ctx context.Context, id uuid.UUID, start time.Time, version int64) (models.Game, error) {
+	return gu.transition(ctx, id, version, models.GamePostponed, func(g *models.Game) error {
+		if start.IsZero() {
+			return nil
+		}
+		if !start.After(time.Now()) {
+			return domain.Invalid(domain.FieldViolation{Field: "game_start", Description: "must be in the future"})
+		}
+		g.GameStart = start
+		return nil
+	})
+}
+
+// transition moves a game to the status after applying change, a non-zero
+// version must match the stored one.
+func (gu *gamesUseCase) transition(ctx context.Context, id uuid.UUID, version int64, to models.GameStatus, change func(*models.Game) error) (models.Game, error) {
+	ctx, cancel := context.WithTimeout(ctx, gu.contextTimeout)
+	defer cancel()
+
+	game, err := gu.gamesRepository.FetchById(ctx, id)
+	if err != nil {
+		return models.Game{}, err
+	}
+	if version != 0 && version != game.Version {
+		return models.Game{}, domain.ErrStaleVersion
+	}
+	if _, err := gu.access.check(ctx, game.TournamentID, transitionAccess[to]...); err != nil {
+		return models.Game{}, err
+	}
+	if !canTransition(game.Status, to) {
+		return models.Game{}, transitionError(game, to)
+	}
+
+	updated := game
+	updated.Participants = nil
+	updated.Status = to
+	if change != nil {
+		if err := change(&updated); err != nil {
+			return models.Game{}, err
+		}
+	}
+	if err := gu.gamesRepository.Update(ctx, &updated); err != nil {
+		return models.Game{}, err
+	}
+
+	updated.Participants = game.Participants
+	return updated, nil
+}
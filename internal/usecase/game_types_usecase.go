@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+)
+
+type gameTypesUseCase struct {
+	gameTypesRepository repository.GameTypesRepository
+	contextTimeout      time.Duration
+}
+
+func NewGameTypesUseCase(gameTypesRepository repository.GameTypesRepository, timeout time.Duration) usecase.GameTypesUseCase {
+	return &gameTypesUseCase{
+		gameTypesRepository: gameTypesRepository,
+		contextTimeout:      timeout,
+	}
+}
+
+func (gtu *gameTypesUseCase) FetchById(ctx context.Context, id uuid.UUID) (models.GameType, error) {
+	ctx, cancel := context.WithTimeout(ctx, gtu.contextTimeout)
+	defer cancel()
+	return gtu.gameTypesRepository.FetchById(ctx, id)
+}
+
+func (gtu *gameTypesUseCase) FetchAll(ctx context.Context) ([]models.GameType, error) {
+	ctx, cancel := context.WithTimeout(ctx, gtu.contextTimeout)
+	defer cancel()
+	return gtu.gameTypesRepository.FetchAll(ctx)
+}
+
+func (gtu *gameTypesUseCase) Update(ctx context.Context, updated *models.GameType) error {
+	ctx, cancel := context.WithTimeout(ctx, gtu.contextTimeout)
+	defer cancel()
+	return gtu.gameTypesRepository.Update(ctx, updated)
+}
+
+func (gtu *gameTypesUseCase) DeleteById(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, gtu.contextTimeout)
+	defer cancel()
+	return gtu.gameTypesRepository.DeleteById(ctx, id)
+}
+
+func (gtu *gameTypesUseCase) Create(ctx context.Context, gt *models.GameType) error {
+	ctx, cancel := context.WithTimeout(ctx, gtu.contextTimeout)
+	defer cancel()
+	return gtu.gameTypesRepository.Create(ctx, gt)
+}
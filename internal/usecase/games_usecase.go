@@ -4,20 +4,27 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"time"
+	"tournaments-core/internal/domain"
 	"tournaments-core/internal/domain/models"
 	"tournaments-core/internal/domain/ports/repository"
 	"tournaments-core/internal/domain/ports/usecase"
 )
 
 type gamesUseCase struct {
-	gamesRepository repository.GamesRepository
-	contextTimeout  time.Duration
+	gamesRepository        repository.GamesRepository
+	participantsRepository repository.ParticipantsRepository
+	gameTypesRepository    repository.GameTypesRepository
+	access                 tournamentAccess
+	contextTimeout         time.Duration
 }
 
-func NewGamesUseCase(gamesRepository repository.GamesRepository, timeout time.Duration) usecase.GamesUseCase {
+func NewGamesUseCase(gamesRepository repository.GamesRepository, participantsRepository repository.ParticipantsRepository, gameTypesRepository repository.GameTypesRepository, grantsRepository repository.TournamentGrantsRepository, timeout time.Duration) usecase.GamesUseCase {
 	return &gamesUseCase{
-		gamesRepository: gamesRepository,
-		contextTimeout:  timeout,
+		gamesRepository:        gamesRepository,
+		participantsRepository: participantsRepository,
+		gameTypesRepository:    gameTypesRepository,
+		access:                 tournamentAccess{grantsRepository},
+		contextTimeout:         timeout,
 	}
 }
 
@@ -27,21 +34,156 @@ func (gu *gamesUseCase) FetchById(ctx context.Context, id uuid.UUID) (models.Gam
 	return gu.gamesRepository.FetchById(ctx, id)
 }
 
-func (gu *gamesUseCase) Update(ctx context.Context, updated *models.Game) error {
+var gameFields = []string{"game_start", "game_type_id", "tournament_id", "round", "participants"}
+
+// Update writes the fields of the mask. Without a mask it writes every field
+// that is set, participants only when they are not nil. A non-zero version
+// must match the stored one, the write itself is always checked against the
+// version the merge was based on.
+func (gu *gamesUseCase) Update(ctx context.Context, updated *models.Game, mask models.UpdateMask) error {
+	ctx, cancel := context.WithTimeout(ctx, gu.contextTimeout)
+	defer cancel()
+	if err := checkMask(mask, gameFields...); err != nil {
+		return err
+	}
+	if len(mask) == 0 {
+		mask = setGameFields(updated)
+	}
+	if err := validateGameUpdate(updated, mask); err != nil {
+		return err
+	}
+
+	current, err := gu.gamesRepository.FetchById(ctx, updated.GameID)
+	if err != nil {
+		return err
+	}
+	if updated.Version != 0 && updated.Version != current.Version {
+		return domain.ErrStaleVersion
+	}
+	if _, err := gu.access.check(ctx, current.TournamentID, organiserAccess...); err != nil {
+		return err
+	}
+
+	merged := current
+	merged.Participants = nil
+	if mask.Has("game_start") && !updated.GameStart.Equal(current.GameStart) {
+		if err := reschedule(&merged, updated.GameStart); err != nil {
+			return err
+		}
+	}
+	if mask.Has("game_type_id") {
+		merged.GameTypeID = updated.GameTypeID
+		if _, err := gu.gameTypesRepository.FetchById(ctx, merged.GameTypeID); err != nil {
+			return err
+		}
+	}
+	if mask.Has("tournament_id") && updated.TournamentID != current.TournamentID {
+		// Moving a game needs access to the tournament it joins as well.
+		merged.TournamentID = updated.TournamentID
+		if _, err := gu.access.check(ctx, merged.TournamentID, organiserAccess...); err != nil {
+			return err
+		}
+	}
+	if mask.Has("round") {
+		merged.Round = updated.Round
+	}
+	if mask.Has("participants") {
+		merged.Participants = updated.Participants
+		if merged.Participants == nil {
+			merged.Participants = []models.GameParticipant{}
+		}
+		if err := gu.checkParticipants(ctx, merged.Participants); err != nil {
+			return err
+		}
+	}
+
+	if err := gu.gamesRepository.Update(ctx, &merged); err != nil {
+		return err
+	}
+	updated.Version = merged.Version
+	return nil
+}
+
+// setGameFields is the mask of an update sent without one.
+func setGameFields(g *models.Game) models.UpdateMask {
+	var mask models.UpdateMask
+	if !g.GameStart.IsZero() {
+		mask = append(mask, "game_start")
+	}
+	if g.GameTypeID != uuid.Nil {
+		mask = append(mask, "game_type_id")
+	}
+	if g.TournamentID.Valid {
+		mask = append(mask, "tournament_id")
+	}
+	if g.Round != 0 {
+		mask = append(mask, "round")
+	}
+	if g.Participants != nil {
+		mask = append(mask, "participants")
+	}
+	return mask
+}
+
+func (gu *gamesUseCase) DeleteById(ctx context.Context, id uuid.UUID, version int64) error {
 	ctx, cancel := context.WithTimeout(ctx, gu.contextTimeout)
 	defer cancel()
-	return gu.gamesRepository.Update(ctx, updated)
+
+	game, err := gu.gamesRepository.FetchById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err := gu.access.check(ctx, game.TournamentID, organiserAccess...); err != nil {
+		return err
+	}
+	return gu.gamesRepository.DeleteById(ctx, id, version)
 }
 
-func (gu *gamesUseCase) DeleteById(ctx context.Context, id uuid.UUID) error {
+func (gu *gamesUseCase) List(ctx context.Context, filter models.GamesFilter) (models.GamesPage, error) {
 	ctx, cancel := context.WithTimeout(ctx, gu.contextTimeout)
 	defer cancel()
-	return gu.gamesRepository.DeleteById(ctx, id)
+	if !filter.StartFrom.IsZero() && !filter.StartTo.IsZero() && !filter.StartFrom.Before(filter.StartTo) {
+		return models.GamesPage{}, domain.InvalidArgument("Start of the time range must be before its end")
+	}
+	return gu.gamesRepository.List(ctx, filter)
 }
 
 func (gu *gamesUseCase) Create(ctx context.Context, g *models.Game) error {
 	ctx, cancel := context.WithTimeout(ctx, gu.contextTimeout)
 	defer cancel()
+	if err := validateNewGame(g, time.Now()); err != nil {
+		return err
+	}
+	g.Status = models.GameScheduled
+	if _, err := gu.access.check(ctx, g.TournamentID, organiserAccess...); err != nil {
+		return err
+	}
+	if _, err := gu.gameTypesRepository.FetchById(ctx, g.GameTypeID); err != nil {
+		return err
+	}
+	if err := gu.checkParticipants(ctx, g.Participants); err != nil {
+		return err
+	}
 	return gu.gamesRepository.Create(ctx, g)
+}
 
+// checkParticipants makes sure every participant is registered and takes a
+// single slot of its own.
+func (gu *gamesUseCase) checkParticipants(ctx context.Context, participants []models.GameParticipant) error {
+	slots := make(map[int]bool, len(participants))
+	seen := make(map[uuid.UUID]bool, len(participants))
+	for _, p := range participants {
+		if slots[p.Slot] {
+			return domain.InvalidArgument("slot %d is taken twice", p.Slot)
+		}
+		if seen[p.ParticipantID] {
+			return domain.InvalidArgument("participant %s takes more than one slot", p.ParticipantID)
+		}
+		slots[p.Slot], seen[p.ParticipantID] = true, true
+
+		if _, err := gu.participantsRepository.FetchById(ctx, p.ParticipantID); err != nil {
+			return err
+		}
+	}
+	return nil
 }
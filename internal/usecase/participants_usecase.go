@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+)
+
+type participantsUseCase struct {
+	participantsRepository repository.ParticipantsRepository
+	access                 tournamentAccess
+	contextTimeout         time.Duration
+}
+
+func NewParticipantsUseCase(participantsRepository repository.ParticipantsRepository, grantsRepository repository.TournamentGrantsRepository, timeout time.Duration) usecase.ParticipantsUseCase {
+	return &participantsUseCase{
+		participantsRepository: participantsRepository,
+		access:                 tournamentAccess{grantsRepository},
+		contextTimeout:         timeout,
+	}
+}
+
+func (pu *participantsUseCase) FetchById(ctx context.Context, id uuid.UUID) (models.Participant, error) {
+	ctx, cancel := context.WithTimeout(ctx, pu.contextTimeout)
+	defer cancel()
+	return pu.participantsRepository.FetchById(ctx, id)
+}
+
+func (pu *participantsUseCase) Update(ctx context.Context, updated *models.Participant) error {
+	ctx, cancel := context.WithTimeout(ctx, pu.contextTimeout)
+	defer cancel()
+
+	current, err := pu.participantsRepository.FetchById(ctx, updated.ParticipantID)
+	if err != nil {
+		return err
+	}
+	kind := updated.Kind
+	if kind == "" {
+		kind = current.Kind
+	}
+	if err := pu.checkRoster(ctx, kind, updated.Members); err != nil {
+		return err
+	}
+	if updated.Subject != "" && updated.Subject != current.Subject {
+		if err := pu.checkSubject(ctx, updated.ParticipantID); err != nil {
+			return err
+		}
+	}
+
+	return pu.participantsRepository.Update(ctx, updated)
+}
+
+func (pu *participantsUseCase) DeleteById(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, pu.contextTimeout)
+	defer cancel()
+	return pu.participantsRepository.DeleteById(ctx, id)
+}
+
+func (pu *participantsUseCase) Create(ctx context.Context, p *models.Participant) error {
+	ctx, cancel := context.WithTimeout(ctx, pu.contextTimeout)
+	defer cancel()
+
+	if p.Kind == "" {
+		p.Kind = models.ParticipantPlayer
+	}
+	if err := pu.checkRoster(ctx, p.Kind, p.Members); err != nil {
+		return err
+	}
+	if p.Subject != "" {
+		if err := pu.checkSubject(ctx, p.ParticipantID); err != nil {
+			return err
+		}
+	}
+
+	return pu.participantsRepository.Create(ctx, p)
+}
+
+// checkSubject lets the caller bind an account to the participant. Whoever
+// is bound reports its results, so only admins and the organisers of every
+// tournament the participant plays in may bind one. A participant that plays
+// in no tournament yet is bound by admins.
+func (pu *participantsUseCase) checkSubject(ctx context.Context, participantId uuid.UUID) error {
+	principal, ok := domain.PrincipalFrom(ctx)
+	if !ok || principal.HasRole(models.RoleAdmin) {
+		return nil
+	}
+
+	tournaments, err := pu.participantsRepository.Tournaments(ctx, participantId)
+	if err != nil {
+		return err
+	}
+	if len(tournaments) == 0 {
+		return domain.PermissionDenied("only admins bind an account to participant %s, it plays in no tournament", participantId)
+	}
+	for _, id := range tournaments {
+		if _, err := pu.access.check(ctx, tournamentOf(id), organiserAccess...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRoster makes sure only teams have members and every member is a
+// registered player.
+func (pu *participantsUseCase) checkRoster(ctx context.Context, kind models.ParticipantKind, members []models.TeamMember) error {
+	switch kind {
+	case models.ParticipantPlayer:
+		if len(members) > 0 {
+			return domain.InvalidArgument("a player cannot have roster members")
+		}
+		return nil
+	case models.ParticipantTeam:
+	default:
+		return domain.InvalidArgument("unknown participant kind %q", kind)
+	}
+
+	for _, m := range members {
+		member, err := pu.participantsRepository.FetchById(ctx, m.PlayerID)
+		if err != nil {
+			return err
+		}
+		if member.Kind != models.ParticipantPlayer {
+			return domain.InvalidArgument("roster member %s is not a player", m.PlayerID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+)
+
+// points is what a result is worth to a participant in round robin and Swiss
+// tables: one for a win, a half for a draw, nothing otherwise.
+func points(r models.Result, participant uuid.UUID) float64 {
+	if r.Outcome == models.OutcomeDraw {
+		for _, p := range r.Placements {
+			if p.ParticipantID == participant {
+				return 0.5
+			}
+		}
+		return 0
+	}
+	if contains(r.Winners(), participant) {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,392 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"unicode/utf8"
+)
+
+// Review returns how the result of a game is being agreed on.
+func (ru *resultsUseCase) Review(ctx context.Context, gameId uuid.UUID) (models.ResultReview, error) {
+	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
+	defer cancel()
+	return ru.reviewsRepository.FetchByGame(ctx, gameId)
+}
+
+// Report records a participant's version of how a game ended, replacing its
+// earlier one. Only live games and finished games without a result are
+// reported. Once every participant has reported, matching reports confirm
+// the result and differing ones open a dispute. A disputed game is confirmed
+// as soon as the reports match again.
+func (ru *resultsUseCase) Report(ctx context.Context, report *models.ResultReport) (models.ResultReview, error) {
+	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
+	defer cancel()
+
+	claim := report.Result()
+	if err := ru.validateResult(ctx, &claim); err != nil {
+		return models.ResultReview{}, err
+	}
+	game, err := ru.checkResult(ctx, &claim)
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+	if err := ru.checkReportable(ctx, game); err != nil {
+		return models.ResultReview{}, err
+	}
+	if !playsIn(game, report.ParticipantID) {
+		return models.ResultReview{}, domain.Invalid(domain.FieldViolation{
+			Field:       "participant_id",
+			Description: "did not play in the game",
+		})
+	}
+
+	subject, err := ru.checkReporter(ctx, game, report.ParticipantID)
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+
+	review, err := ru.reviewsRepository.FetchByGame(ctx, game.GameID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return models.ResultReview{}, err
+	}
+	if review.Status.Final() {
+		return models.ResultReview{}, reviewClosed(review)
+	}
+
+	report.ReportID = uuid.New()
+	report.Subject = subject
+	report.WinnerID = claim.WinnerID
+	report.Placements = claim.Placements
+	report.Outcome = claim.Outcome
+	report.ForfeitedBy = claim.ForfeitedBy
+	if err := ru.reviewsRepository.SaveReport(ctx, report); err != nil {
+		return models.ResultReview{}, err
+	}
+
+	review, err = ru.reviewsRepository.FetchByGame(ctx, game.GameID)
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+	return ru.settle(ctx, game, review)
+}
+
+// settle confirms the result once every participant has reported the same
+// one and opens a dispute when they differ.
+func (ru *resultsUseCase) settle(ctx context.Context, game models.Game, review models.ResultReview) (models.ResultReview, error) {
+	if len(review.Reports) < len(game.Participants) {
+		return review, nil
+	}
+
+	if !reportsAgree(review.Reports) {
+		if review.Status == models.ReviewDisputed {
+			return review, nil
+		}
+		review.Status = models.ReviewDisputed
+		return review, ru.reviewsRepository.SetStatus(ctx, &review)
+	}
+
+	result := review.Reports[0].Result()
+	result.ResultID = uuid.New()
+	result.Comment = ""
+	err := ru.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ru.record(ctx, game, &result); err != nil {
+			return err
+		}
+		review.Status = models.ReviewConfirmed
+		review.ResultID = uuid.NullUUID{UUID: result.ResultID, Valid: true}
+		return ru.reviewsRepository.SetStatus(ctx, &review)
+	})
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		// Another report or an official recorded the result first.
+		return ru.closeWithResult(ctx, game.GameID)
+	}
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+	return review, nil
+}
+
+// closeWithResult ends the review of a game that got its result elsewhere
+// while it was being settled. A review still open is resolved with that
+// result.
+func (ru *resultsUseCase) closeWithResult(ctx context.Context, gameId uuid.UUID) (models.ResultReview, error) {
+	review, err := ru.reviewsRepository.FetchByGame(ctx, gameId)
+	if err != nil || review.Status.Final() {
+		return review, err
+	}
+
+	result, err := ru.resultRepository.FetchByGameId(ctx, gameId)
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+	review.Status = models.ReviewResolved
+	review.ResultID = uuid.NullUUID{UUID: result.ResultID, Valid: true}
+	if err := ru.reviewsRepository.SetStatus(ctx, &review); err != nil {
+		return models.ResultReview{}, err
+	}
+	return review, nil
+}
+
+// checkReportable makes sure the game still waits for its result: it is live
+// or finished without one.
+func (ru *resultsUseCase) checkReportable(ctx context.Context, game models.Game) error {
+	if game.Status != models.GameLive && game.Status != models.GameFinished {
+		return domain.Conflict("game %s is %s, only live and finished games are reported", game.GameID, game.Status)
+	}
+
+	result, err := ru.resultRepository.FetchByGameId(ctx, game.GameID)
+	if err == nil {
+		return domain.Conflict("game %s already has result %s", game.GameID, result.ResultID)
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// reportsAgree reports whether every report claims the same outcome and the
+// same placements and scores.
+func reportsAgree(reports []models.ResultReport) bool {
+	first := reports[0]
+	places := make(map[uuid.UUID]models.Placement, len(first.Placements))
+	for _, p := range first.Placements {
+		places[p.ParticipantID] = p
+	}
+
+	for _, r := range reports[1:] {
+		if r.Outcome != first.Outcome || r.ForfeitedBy != first.ForfeitedBy || r.WinnerID != first.WinnerID {
+			return false
+		}
+		if len(r.Placements) != len(first.Placements) {
+			return false
+		}
+		for _, p := range r.Placements {
+			if places[p.ParticipantID] != p {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Comment adds a note to the review of a game that is still open. The
+// participants of the game and its officials may comment.
+func (ru *resultsUseCase) Comment(ctx context.Context, c *models.DisputeComment) error {
+	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
+	defer cancel()
+
+	var v validator
+	v.check(c.Body != "", "body", "must not be empty")
+	v.check(utf8.RuneCountInString(c.Body) <= maxCommentLength, "body", "must be at most %d characters", maxCommentLength)
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	review, err := ru.reviewsRepository.FetchByGame(ctx, c.GameID)
+	if err != nil {
+		return err
+	}
+	if review.Status.Final() {
+		return reviewClosed(review)
+	}
+
+	game, err := ru.gamesRepository.FetchById(ctx, c.GameID)
+	if err != nil {
+		return err
+	}
+	if err := ru.checkReviewer(ctx, game); err != nil {
+		return err
+	}
+
+	c.CommentID = uuid.New()
+	c.Subject = subjectOf(ctx)
+	return ru.reviewsRepository.AddComment(ctx, c)
+}
+
+// Resolve settles an open review with the result a referee decided on, or
+// with the report they accept when acceptReport is set.
+func (ru *resultsUseCase) Resolve(ctx context.Context, r *models.Result, acceptReport uuid.NullUUID, resolution string) (models.ResultReview, error) {
+	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
+	defer cancel()
+
+	if utf8.RuneCountInString(resolution) > maxCommentLength {
+		return models.ResultReview{}, domain.Invalid(domain.FieldViolation{
+			Field:       "resolution",
+			Description: fmt.Sprintf("must be at most %d characters", maxCommentLength),
+		})
+	}
+
+	review, err := ru.reviewsRepository.FetchByGame(ctx, r.GameID)
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+	if review.Status.Final() {
+		return models.ResultReview{}, reviewClosed(review)
+	}
+
+	if acceptReport.Valid {
+		i := slices.IndexFunc(review.Reports, func(report models.ResultReport) bool {
+			return report.ReportID == acceptReport.UUID
+		})
+		if i < 0 {
+			return models.ResultReview{}, domain.Invalid(domain.FieldViolation{
+				Field:       "accept_report_id",
+				Description: "is not a report of the game",
+			})
+		}
+		*r = review.Reports[i].Result()
+	}
+
+	if err := ru.validateResult(ctx, r); err != nil {
+		return models.ResultReview{}, err
+	}
+	game, err := ru.checkResult(ctx, r)
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+	if _, err := ru.access.check(ctx, game.TournamentID, officialAccess...); err != nil {
+		return models.ResultReview{}, err
+	}
+
+	r.ResultID = uuid.New()
+	err = ru.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ru.record(ctx, game, r); err != nil {
+			return err
+		}
+		review.Status = models.ReviewResolved
+		review.ResultID = uuid.NullUUID{UUID: r.ResultID, Valid: true}
+		review.ResolvedBy = subjectOf(ctx)
+		review.Resolution = resolution
+		return ru.reviewsRepository.SetStatus(ctx, &review)
+	})
+	if err != nil {
+		return models.ResultReview{}, err
+	}
+	return review, nil
+}
+
+// closeReview marks the open review of a game resolved by a result recorded
+// directly.
+func (ru *resultsUseCase) closeReview(ctx context.Context, gameId uuid.UUID, resultId uuid.UUID) error {
+	review, err := ru.reviewsRepository.FetchByGame(ctx, gameId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if review.Status.Final() {
+		return nil
+	}
+
+	review.Status = models.ReviewResolved
+	review.ResultID = uuid.NullUUID{UUID: resultId, Valid: true}
+	review.ResolvedBy = subjectOf(ctx)
+	return ru.reviewsRepository.SetStatus(ctx, &review)
+}
+
+// checkReporter makes sure the caller reports for the participant: it signs
+// in as the player or as a member of the team. Admins and the service itself
+// report for everyone. Referees and organisers only report in tournaments
+// they have access to, so a binding made elsewhere does not let them confirm
+// results of tournaments they do not run. It returns the subject the report
+// is recorded for.
+func (ru *resultsUseCase) checkReporter(ctx context.Context, game models.Game, participantId uuid.UUID) (string, error) {
+	principal, ok := domain.PrincipalFrom(ctx)
+	if !ok {
+		return "", nil
+	}
+	if principal.HasRole(models.RoleAdmin) {
+		return principal.Subject, nil
+	}
+
+	represents, err := ru.represents(ctx, principal.Subject, participantId)
+	if err != nil {
+		return "", err
+	}
+	if !represents {
+		return "", domain.PermissionDenied("%s does not report for participant %s", principal.Subject, participantId)
+	}
+	if principal.HasAnyRole(models.RoleReferee, models.RoleOrganiser) {
+		if _, err := ru.access.check(ctx, game.TournamentID, officialAccess...); err != nil {
+			return "", err
+		}
+	}
+	return principal.Subject, nil
+}
+
+func (ru *resultsUseCase) represents(ctx context.Context, subject string, participantId uuid.UUID) (bool, error) {
+	participant, err := ru.participants.FetchById(ctx, participantId)
+	if err != nil {
+		return false, err
+	}
+	if participant.Subject == subject {
+		return true, nil
+	}
+
+	for _, m := range participant.Members {
+		player, err := ru.participants.FetchById(ctx, m.PlayerID)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if player.Subject == subject {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkReviewer lets the participants of a game and its officials take part
+// in its review.
+func (ru *resultsUseCase) checkReviewer(ctx context.Context, game models.Game) error {
+	principal, ok := domain.PrincipalFrom(ctx)
+	if !ok || principal.HasRole(models.RoleAdmin) {
+		return nil
+	}
+
+	for _, p := range game.Participants {
+		represents, err := ru.represents(ctx, principal.Subject, p.ParticipantID)
+		if err != nil {
+			return err
+		}
+		if represents {
+			return nil
+		}
+	}
+
+	if !principal.HasAnyRole(models.RoleReferee, models.RoleOrganiser) {
+		return domain.PermissionDenied("%s takes no part in game %s", principal.Subject, game.GameID)
+	}
+	_, err := ru.access.check(ctx, game.TournamentID, officialAccess...)
+	return err
+}
+
+func playsIn(game models.Game, participantId uuid.UUID) bool {
+	return slices.ContainsFunc(game.Participants, func(p models.GameParticipant) bool {
+		return p.ParticipantID == participantId
+	})
+}
+
+func subjectOf(ctx context.Context) string {
+	if principal, ok := domain.PrincipalFrom(ctx); ok {
+		return principal.Subject
+	}
+	return ""
+}
+
+func reviewClosed(review models.ResultReview) error {
+	return &domain.Error{
+		Kind:     domain.ErrConflict,
+		Reason:   "REVIEW_CLOSED",
+		Resource: "result review",
+		Message:  "the result of game " + review.GameID.String() + " is already " + string(review.Status),
+	}
+}
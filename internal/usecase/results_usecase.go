@@ -2,22 +2,43 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
+	"log"
+	"slices"
+	"sort"
 	"time"
+	"tournaments-core/internal/domain"
 	"tournaments-core/internal/domain/models"
 	"tournaments-core/internal/domain/ports/repository"
 	"tournaments-core/internal/domain/ports/usecase"
 )
 
 type resultsUseCase struct {
-	resultRepository repository.ResultsRepository
-	contextTimeout   time.Duration
+	resultRepository      repository.ResultsRepository
+	gamesRepository       repository.GamesRepository
+	tournamentsRepository repository.TournamentsRepository
+	reviewsRepository     repository.ResultReviewsRepository
+	participants          repository.ParticipantsRepository
+	attachments           repository.AttachmentsRepository
+	blobs                 repository.BlobStore
+	transactor            repository.Transactor
+	access                tournamentAccess
+	contextTimeout        time.Duration
 }
 
-func NewResultsUseCase(r repository.ResultsRepository, timeout time.Duration) usecase.ResultsUseCase {
+func NewResultsUseCase(r repository.ResultsRepository, g repository.GamesRepository, t repository.TournamentsRepository, grants repository.TournamentGrantsRepository, reviews repository.ResultReviewsRepository, participants repository.ParticipantsRepository, attachments repository.AttachmentsRepository, blobs repository.BlobStore, transactor repository.Transactor, timeout time.Duration) usecase.ResultsUseCase {
 	return &resultsUseCase{
-		resultRepository: r,
-		contextTimeout:   timeout,
+		resultRepository:      r,
+		gamesRepository:       g,
+		tournamentsRepository: t,
+		reviewsRepository:     reviews,
+		participants:          participants,
+		attachments:           attachments,
+		blobs:                 blobs,
+		transactor:            transactor,
+		access:                tournamentAccess{grants},
+		contextTimeout:        timeout,
 	}
 }
 
@@ -27,20 +48,649 @@ func (ru *resultsUseCase) FetchById(ctx context.Context, id uuid.UUID) (models.R
 	return ru.resultRepository.FetchById(ctx, id)
 }
 
-func (ru *resultsUseCase) DeleteById(ctx context.Context, id uuid.UUID) error {
+func (ru *resultsUseCase) FetchByGameId(ctx context.Context, gameId uuid.UUID) (models.Result, error) {
 	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
 	defer cancel()
-	return ru.resultRepository.DeleteById(ctx, id)
+	return ru.resultRepository.FetchByGameId(ctx, gameId)
 }
 
+func (ru *resultsUseCase) List(ctx context.Context, filter models.ResultsFilter) (models.ResultsPage, error) {
+	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
+	defer cancel()
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return models.ResultsPage{}, domain.InvalidArgument("Start of the time range must be before its end")
+	}
+	return ru.resultRepository.List(ctx, filter)
+}
+
+// DeleteById removes a result and puts the game it ended back live, its
+// participants may report the game again. The attachments of the result and
+// of the reports behind it go along with their files.
+func (ru *resultsUseCase) DeleteById(ctx context.Context, id uuid.UUID, version int64) error {
+	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
+	defer cancel()
+
+	result, err := ru.resultRepository.FetchById(ctx, id)
+	if err != nil {
+		return err
+	}
+	game, err := ru.gamesRepository.FetchById(ctx, result.GameID)
+	if err != nil {
+		return err
+	}
+	if _, err := ru.access.check(ctx, game.TournamentID, organiserAccess...); err != nil {
+		return err
+	}
+
+	attachments, err := ru.attachmentsOf(ctx, result)
+	if err != nil {
+		return err
+	}
+	err = ru.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ru.resultRepository.DeleteById(ctx, id, version); err != nil {
+			return err
+		}
+		if err := ru.reviewsRepository.DeleteByGame(ctx, game.GameID); err != nil {
+			return err
+		}
+
+		if game.Status == endedStatus(&result) {
+			return ru.setGameStatus(ctx, game, models.GameLive)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The rows are gone with the result, only the files are left. A file
+	// that fails to go is left behind, the result stays deleted.
+	for _, a := range attachments {
+		if !a.HasContent() {
+			continue
+		}
+		if err := ru.blobs.Delete(ctx, a.StorageKey); err != nil {
+			log.Printf("[ATTACHMENTS]: Failed to delete file %s of attachment %s: %v", a.StorageKey, a.AttachmentID, err)
+		}
+	}
+	return nil
+}
+
+// attachmentsOf returns the attachments of a result and of the reports of
+// its game.
+func (ru *resultsUseCase) attachmentsOf(ctx context.Context, result models.Result) ([]models.Attachment, error) {
+	attachments, err := ru.attachments.FetchByResult(ctx, result.ResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := ru.reviewsRepository.FetchByGame(ctx, result.GameID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return attachments, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range review.Reports {
+		attachments = append(attachments, r.Attachments...)
+	}
+	return attachments, nil
+}
+
+// endedStatus is the status a game reaches once the result is recorded.
+func endedStatus(r *models.Result) models.GameStatus {
+	if r.Outcome == models.OutcomeCancelled {
+		return models.GameCancelled
+	}
+	return models.GameFinished
+}
+
+func (ru *resultsUseCase) setGameStatus(ctx context.Context, game models.Game, status models.GameStatus) error {
+	game.Participants = nil
+	game.Status = status
+	return ru.gamesRepository.Update(ctx, &game)
+}
+
+// Create records the result an official decided on, closing the review of
+// the game when its participants have reported it.
 func (ru *resultsUseCase) Create(ctx context.Context, r *models.Result) error {
 	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
 	defer cancel()
-	return ru.resultRepository.Create(ctx, r)
+
+	if err := ru.validateResult(ctx, r); err != nil {
+		return err
+	}
+
+	game, err := ru.checkResult(ctx, r)
+	if err != nil {
+		return err
+	}
+	if _, err := ru.access.check(ctx, game.TournamentID, officialAccess...); err != nil {
+		return err
+	}
+
+	return ru.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ru.record(ctx, game, r); err != nil {
+			return err
+		}
+		return ru.closeReview(ctx, game.GameID, r.ResultID)
+	})
 }
 
-func (ru *resultsUseCase) Update(ctx context.Context, updated *models.Result) error {
+// record writes a final result, ends the game and moves the bracket on, all
+// or nothing.
+func (ru *resultsUseCase) record(ctx context.Context, game models.Game, r *models.Result) error {
+	return ru.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ru.resultRepository.Create(ctx, r); err != nil {
+			return err
+		}
+		if game.Status == models.GameLive {
+			if err := ru.setGameStatus(ctx, game, endedStatus(r)); err != nil {
+				return err
+			}
+		}
+		return ru.advance(ctx, game, r)
+	})
+}
+
+var resultFields = []string{"game_id", "winner_id", "comment", "placements", "outcome", "forfeited_by"}
+
+// outcomeFields describe how the game ended, a mask naming any of them
+// replaces all of them so that placements and the winner are derived again.
+var outcomeFields = []string{"winner_id", "placements", "outcome", "forfeited_by"}
+
+// Update writes the fields of the mask. Without a mask the result is
+// overwritten, keeping the stored outcome when none is sent and the stored
+// placements when the winner and outcome do not change. A non-zero version
+// must match the stored one.
+func (ru *resultsUseCase) Update(ctx context.Context, updated *models.Result, mask models.UpdateMask) error {
 	ctx, cancel := context.WithTimeout(ctx, ru.contextTimeout)
 	defer cancel()
-	return ru.resultRepository.Update(ctx, updated)
+	if err := checkMask(mask, resultFields...); err != nil {
+		return err
+	}
+
+	current, err := ru.resultRepository.FetchById(ctx, updated.ResultID)
+	if err != nil {
+		return err
+	}
+	if updated.Version != 0 && updated.Version != current.Version {
+		return domain.ErrStaleVersion
+	}
+	updated.Version = current.Version
+
+	if len(mask) > 0 {
+		merged := current
+		if mask.Has("game_id") {
+			merged.GameID = updated.GameID
+		}
+		if mask.Has("comment") {
+			merged.Comment = updated.Comment
+		}
+		if mask.HasAny(outcomeFields...) {
+			merged.WinnerID = updated.WinnerID
+			merged.Placements = updated.Placements
+			merged.Outcome = updated.Outcome
+			merged.ForfeitedBy = updated.ForfeitedBy
+		}
+		*updated = merged
+	} else {
+		if updated.Outcome == "" {
+			updated.Outcome = current.Outcome
+		}
+		if updated.Placements == nil && updated.WinnerID == current.WinnerID && updated.Outcome == current.Outcome {
+			// Clients that only send a winner keep the stored placements as
+			// long as they do not change how the game ended.
+			updated.Placements = current.Placements
+			if !updated.ForfeitedBy.Valid {
+				updated.ForfeitedBy = current.ForfeitedBy
+			}
+		}
+	}
+
+	if err := ru.validateResult(ctx, updated); err != nil {
+		return err
+	}
+	game, err := ru.checkResult(ctx, updated)
+	if err != nil {
+		return err
+	}
+	if _, err := ru.access.check(ctx, game.TournamentID, officialAccess...); err != nil {
+		return err
+	}
+	if updated.GameID != current.GameID {
+		// The result leaves its old game, that needs access as well.
+		previous, err := ru.gamesRepository.FetchById(ctx, current.GameID)
+		if err != nil {
+			return err
+		}
+		if _, err := ru.access.check(ctx, previous.TournamentID, officialAccess...); err != nil {
+			return err
+		}
+		// Standings and advancement are built from the results of tournament
+		// games, those results stay with their game.
+		if previous.TournamentID.Valid || game.TournamentID.Valid {
+			return domain.Conflict("result %s cannot move from game %s to game %s, results of tournament games stay with their game", current.ResultID, current.GameID, game.GameID)
+		}
+	}
+
+	// Finished and cancelled games are final, an edit cannot turn one into
+	// the other.
+	ended := endedStatus(updated)
+	if game.Status != ended && !canTransition(game.Status, ended) {
+		return transitionError(game, ended)
+	}
+
+	return ru.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := ru.resultRepository.Update(ctx, updated); err != nil {
+			return err
+		}
+		if game.Status != ended {
+			if err := ru.setGameStatus(ctx, game, ended); err != nil {
+				return err
+			}
+		}
+		return ru.reroute(ctx, game, &current, updated)
+	})
+}
+
+// checkResult returns the game of a result after checking the result against
+// it. Outcomes follow these rules:
+//   - a win has a winner, a result with a winner only places it first;
+//   - a draw has no winner, everyone shares first place with equal scores;
+//   - a forfeit or disqualification names who forfeited, in a two-sided
+//     game the other side wins;
+//   - a cancelled game has neither winner nor placements.
+//
+// Everyone placed must have played in the game and places must follow
+// competition ranking: 1, 2, 2, 4. WinnerID is set to the first participant
+// placed first. Elimination bracket games cannot end without a winner.
+func (ru *resultsUseCase) checkResult(ctx context.Context, r *models.Result) (models.Game, error) {
+	game, err := ru.gamesRepository.FetchById(ctx, r.GameID)
+	if err != nil {
+		return models.Game{}, err
+	}
+
+	played := make(map[uuid.UUID]bool, len(game.Participants))
+	for _, p := range game.Participants {
+		played[p.ParticipantID] = true
+	}
+
+	if r.Outcome == "" {
+		r.Outcome = models.OutcomeWin
+	}
+	if r.ForfeitedBy.Valid && !played[r.ForfeitedBy.UUID] {
+		return models.Game{}, domain.InvalidArgument("participant %s did not play in game %s", r.ForfeitedBy.UUID, r.GameID)
+	}
+
+	switch r.Outcome {
+	case models.OutcomeWin:
+		if r.ForfeitedBy.Valid {
+			return models.Game{}, domain.InvalidArgument("only forfeits and disqualifications record who forfeited")
+		}
+	case models.OutcomeDraw:
+		if r.WinnerID != uuid.Nil || r.ForfeitedBy.Valid {
+			return models.Game{}, domain.InvalidArgument("a draw has neither winner nor forfeit")
+		}
+		if len(r.Placements) == 0 {
+			for _, p := range game.Participants {
+				r.Placements = append(r.Placements, models.Placement{ParticipantID: p.ParticipantID, Place: 1})
+			}
+		}
+	case models.OutcomeForfeit, models.OutcomeDisqualification:
+		if !r.ForfeitedBy.Valid {
+			return models.Game{}, domain.InvalidArgument("a %s must record who forfeited", r.Outcome)
+		}
+		if r.WinnerID == r.ForfeitedBy.UUID {
+			return models.Game{}, domain.InvalidArgument("participant %s cannot both forfeit and win", r.WinnerID)
+		}
+		if len(r.Placements) == 0 && len(game.Participants) == 2 {
+			var other uuid.UUID
+			for _, p := range game.Participants {
+				if p.ParticipantID != r.ForfeitedBy.UUID {
+					other = p.ParticipantID
+				}
+			}
+			if r.WinnerID != uuid.Nil && r.WinnerID != other {
+				return models.Game{}, domain.InvalidArgument("participant %s forfeited to %s, not to %s", r.ForfeitedBy.UUID, other, r.WinnerID)
+			}
+			r.WinnerID = other
+			r.Placements = []models.Placement{
+				{ParticipantID: r.WinnerID, Place: 1},
+				{ParticipantID: r.ForfeitedBy.UUID, Place: 2},
+			}
+		}
+	case models.OutcomeCancelled:
+		if r.WinnerID != uuid.Nil || r.ForfeitedBy.Valid || len(r.Placements) > 0 {
+			return models.Game{}, domain.InvalidArgument("a cancelled game has neither winner, forfeit nor placements")
+		}
+	default:
+		return models.Game{}, domain.InvalidArgument("unknown outcome %q", r.Outcome)
+	}
+
+	if len(r.Placements) == 0 && r.Outcome != models.OutcomeCancelled {
+		if r.WinnerID == uuid.Nil {
+			return models.Game{}, domain.InvalidArgument("a %s needs a winner or placements", r.Outcome)
+		}
+		r.Placements = []models.Placement{{ParticipantID: r.WinnerID, Place: 1}}
+	}
+
+	sort.SliceStable(r.Placements, func(i, j int) bool {
+		return r.Placements[i].Place < r.Placements[j].Place
+	})
+
+	placed := make(map[uuid.UUID]bool, len(r.Placements))
+	for i, p := range r.Placements {
+		if !played[p.ParticipantID] {
+			return models.Game{}, domain.InvalidArgument("participant %s did not play in game %s", p.ParticipantID, r.GameID)
+		}
+		if placed[p.ParticipantID] {
+			return models.Game{}, domain.InvalidArgument("participant %s is placed more than once", p.ParticipantID)
+		}
+		placed[p.ParticipantID] = true
+
+		if p.Place != i+1 && (i == 0 || p.Place != r.Placements[i-1].Place) {
+			return models.Game{}, domain.InvalidArgument("participant %s cannot be placed %d", p.ParticipantID, p.Place)
+		}
+		if r.Outcome == models.OutcomeDraw && (p.Place != 1 || p.Score != r.Placements[0].Score) {
+			return models.Game{}, domain.InvalidArgument("a draw places everyone first with equal scores")
+		}
+		if r.ForfeitedBy.Valid && p.ParticipantID == r.ForfeitedBy.UUID && p.Place == 1 {
+			return models.Game{}, domain.InvalidArgument("participant %s cannot both forfeit and win", p.ParticipantID)
+		}
+	}
+
+	winners := r.Winners()
+	switch {
+	case len(winners) == 0:
+		if game.Bracket == models.BracketWinners || game.Bracket == models.BracketLosers || game.Bracket == models.BracketGrandFinal {
+			return models.Game{}, domain.InvalidArgument("elimination games cannot end in a %s", r.Outcome)
+		}
+		r.WinnerID = uuid.Nil
+	case r.WinnerID != uuid.Nil && !contains(winners, r.WinnerID):
+		return models.Game{}, domain.InvalidArgument("winner %s is not placed first", r.WinnerID)
+	default:
+		r.WinnerID = winners[0]
+	}
+
+	return game, nil
+}
+
+// reroute moves the bracket on again when an edit changes who won a game.
+// Games the old winner or loser moved into must not have started, and group
+// and Swiss tables cannot change once the next stage is drawn from them.
+func (ru *resultsUseCase) reroute(ctx context.Context, game models.Game, previous, r *models.Result) error {
+	if !game.TournamentID.Valid || game.Round == 0 || slices.Equal(previous.Winners(), r.Winners()) {
+		return nil
+	}
+
+	games, err := ru.gamesRepository.FetchByTournament(ctx, game.TournamentID.UUID)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range games {
+		switch {
+		case game.Bracket == models.BracketGroup && g.Bracket != models.BracketGroup:
+			return domain.Conflict("the playoff has been drawn from the group of game %s", game.GameID)
+		case game.Bracket == models.BracketSwiss && g.Round > game.Round:
+			return domain.Conflict("round %d has been paired after game %s", g.Round, game.GameID)
+		case fedBy(game, g) && g.Status != models.GameScheduled && g.Status != models.GameCheckIn && g.Status != models.GamePostponed:
+			return domain.Conflict("game %s that game %s moved into is already %s", g.GameID, game.GameID, g.Status)
+		}
+	}
+
+	// The reset grand final is only played when the losers bracket finalist
+	// wins the first one, advance lays it out again if it is still needed.
+	if game.Bracket == models.BracketGrandFinal && game.Round == 1 {
+		for _, g := range games {
+			if g.Bracket == models.BracketGrandFinal && g.Round == 2 {
+				if err := ru.gamesRepository.DeleteById(ctx, g.GameID, 0); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return ru.advance(ctx, game, r)
+}
+
+// fedBy reports whether the participants of game move into next.
+func fedBy(game, next models.Game) bool {
+	if game.WinnerNext.GameID.Valid || game.LoserNext.GameID.Valid {
+		return next.GameID == game.WinnerNext.GameID.UUID || next.GameID == game.LoserNext.GameID.UUID
+	}
+	switch game.Bracket {
+	case models.BracketWinners:
+		round, position, _ := nextBracketSlot(game)
+		return next.Bracket == models.BracketWinners && next.Round == round && next.Position == position
+	case models.BracketGrandFinal:
+		return next.Bracket == models.BracketGrandFinal && next.Round == game.Round+1
+	}
+	return false
+}
+
+// advance routes the participants of a decided bracket game into the games
+// they play next.
+func (ru *resultsUseCase) advance(ctx context.Context, game models.Game, r *models.Result) error {
+	if !game.TournamentID.Valid || game.Round == 0 {
+		return nil
+	}
+
+	tournament, err := ru.tournamentsRepository.FetchById(ctx, game.TournamentID.UUID)
+	if err != nil {
+		return err
+	}
+
+	switch tournament.Format {
+	case models.FormatSingleElimination:
+		return ru.advanceSingleElimination(ctx, tournament, game, r.WinnerID)
+	case models.FormatDoubleElimination:
+		return ru.advanceDoubleElimination(ctx, tournament, game, r.WinnerID)
+	case models.FormatRoundRobin:
+		return ru.advanceRoundRobin(ctx, tournament)
+	case models.FormatGroupStage:
+		if game.Bracket == models.BracketGroup {
+			return ru.advanceGroupStage(ctx, tournament)
+		}
+		return ru.advanceSingleElimination(ctx, tournament, game, r.WinnerID)
+	case models.FormatSwiss:
+		return ru.advanceSwiss(ctx, tournament, game.Round)
+	}
+	return nil
+}
+
+// advanceSingleElimination moves the winner into the game of the next round,
+// creating that game if it does not exist yet. A result for the final
+// finishes the tournament.
+func (ru *resultsUseCase) advanceSingleElimination(ctx context.Context, tournament models.Tournament, game models.Game, winnerId uuid.UUID) error {
+	games, err := ru.gamesRepository.FetchByTournament(ctx, tournament.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	round, position, slot := nextBracketSlot(game)
+	winner := models.GameParticipant{ParticipantID: winnerId, Slot: slot}
+
+	lastRound := 0
+	for _, g := range games {
+		if g.Bracket != models.BracketWinners {
+			continue
+		}
+		if g.Round > lastRound {
+			lastRound = g.Round
+		}
+		if g.Round == round && g.Position == position {
+			return ru.gamesRepository.SetParticipant(ctx, g.GameID, winner)
+		}
+	}
+
+	if game.Round >= lastRound {
+		return ru.finishTournament(ctx, tournament.TournamentID)
+	}
+
+	// The next round starts a slot after this one.
+	next := newBracketGame(tournament, models.BracketWinners, round, position)
+	next.GameStart = game.GameStart.Add(tournament.SlotLength)
+	next.Participants = []models.GameParticipant{winner}
+	return ru.gamesRepository.Create(ctx, &next)
+}
+
+// advanceDoubleElimination follows the winner and loser links laid out by
+// DoubleEliminationBracket. When the losers bracket finalist takes the first
+// grand final and the tournament allows it, a second grand final is played.
+func (ru *resultsUseCase) advanceDoubleElimination(ctx context.Context, tournament models.Tournament, game models.Game, winnerId uuid.UUID) error {
+	winnerSlot := -1
+	var loser uuid.NullUUID
+	for _, p := range game.Participants {
+		if p.ParticipantID == winnerId {
+			winnerSlot = p.Slot
+		} else {
+			loser = uuid.NullUUID{UUID: p.ParticipantID, Valid: true}
+		}
+	}
+
+	if game.Bracket == models.BracketGrandFinal {
+		// Slot 1 of the grand final is held by the losers bracket finalist.
+		if game.Round == 1 && tournament.GrandFinalReset && winnerSlot == 1 {
+			reset := newBracketGame(tournament, models.BracketGrandFinal, 2, 0)
+			reset.GameStart = game.GameStart.Add(tournament.SlotLength)
+			reset.Participants = game.Participants
+			return ru.gamesRepository.Create(ctx, &reset)
+		}
+		return ru.finishTournament(ctx, tournament.TournamentID)
+	}
+
+	if game.WinnerNext.GameID.Valid {
+		err := ru.gamesRepository.SetParticipant(ctx, game.WinnerNext.GameID.UUID, models.GameParticipant{
+			ParticipantID: winnerId,
+			Slot:          game.WinnerNext.Slot,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if game.LoserNext.GameID.Valid && loser.Valid {
+		err := ru.gamesRepository.SetParticipant(ctx, game.LoserNext.GameID.UUID, models.GameParticipant{
+			ParticipantID: loser.UUID,
+			Slot:          game.LoserNext.Slot,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advanceRoundRobin finishes the tournament once every game has a result.
+func (ru *resultsUseCase) advanceRoundRobin(ctx context.Context, tournament models.Tournament) error {
+	games, results, err := ru.tournamentResults(ctx, tournament.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range games {
+		if _, ok := results[g.GameID]; !ok {
+			return nil
+		}
+	}
+
+	return ru.finishTournament(ctx, tournament.TournamentID)
+}
+
+// advanceGroupStage seeds the playoff bracket from the group tables once the
+// last group game has a result. The playoff starts one slot after the last
+// group round.
+func (ru *resultsUseCase) advanceGroupStage(ctx context.Context, tournament models.Tournament) error {
+	games, results, err := ru.tournamentResults(ctx, tournament.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	var lastStart time.Time
+	for _, g := range games {
+		if g.Bracket != models.BracketGroup {
+			// The playoff has already been drawn.
+			return nil
+		}
+		if _, ok := results[g.GameID]; !ok {
+			return nil
+		}
+		if g.GameStart.After(lastStart) {
+			lastStart = g.GameStart
+		}
+	}
+
+	playoff := tournament
+	playoff.StartsAt = lastStart.Add(tournament.SlotLength)
+	bracket, err := SingleEliminationBracket(playoff, PlayoffSeeds(tournament, games, results))
+	if err != nil {
+		return err
+	}
+
+	return ru.gamesRepository.CreateMany(ctx, bracket)
+}
+
+// advanceSwiss pairs the next Swiss round once every game of the current
+// one has a result, and finishes the tournament after the last round.
+func (ru *resultsUseCase) advanceSwiss(ctx context.Context, tournament models.Tournament, round int) error {
+	games, results, err := ru.tournamentResults(ctx, tournament.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	participants := 0
+	for _, g := range games {
+		if g.Round > round {
+			// The next round has already been paired.
+			return nil
+		}
+		if g.Round == 1 {
+			participants += len(g.Participants)
+		}
+		if _, ok := results[g.GameID]; g.Round == round && len(g.Participants) > 1 && !ok {
+			return nil
+		}
+	}
+
+	if round >= SwissRounds(tournament, participants) {
+		return ru.finishTournament(ctx, tournament.TournamentID)
+	}
+
+	next, err := SwissNextRound(tournament, games, results, round+1)
+	if err != nil {
+		return err
+	}
+
+	return ru.gamesRepository.CreateMany(ctx, next)
+}
+
+// tournamentResults returns the games of a tournament along with the result
+// of every game that has one.
+func (ru *resultsUseCase) tournamentResults(ctx context.Context, id uuid.UUID) ([]models.Game, map[uuid.UUID]models.Result, error) {
+	games, err := ru.gamesRepository.FetchByTournament(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results, err := ru.resultRepository.FetchByTournament(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byGame := make(map[uuid.UUID]models.Result, len(results))
+	for _, r := range results {
+		byGame[r.GameID] = r
+	}
+
+	return games, byGame, nil
+}
+
+func (ru *resultsUseCase) finishTournament(ctx context.Context, id uuid.UUID) error {
+	return ru.tournamentsRepository.Update(ctx, &models.Tournament{
+		TournamentID: id,
+		Status:       models.TournamentFinished,
+	})
 }
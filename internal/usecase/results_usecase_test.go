@@ -0,0 +1,547 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sort"
+	"testing"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+// memoryStore keeps the games, results and tournaments the results use case
+// works on. The repositories below share it.
+type memoryStore struct {
+	games       map[uuid.UUID]models.Game
+	results     map[uuid.UUID]models.Result
+	tournaments map[uuid.UUID]models.Tournament
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		games:       make(map[uuid.UUID]models.Game),
+		results:     make(map[uuid.UUID]models.Result),
+		tournaments: make(map[uuid.UUID]models.Tournament),
+	}
+}
+
+type memoryGames struct {
+	repository.GamesRepository
+	*memoryStore
+}
+
+func (s memoryGames) FetchById(_ context.Context, id uuid.UUID) (models.Game, error) {
+	g, ok := s.games[id]
+	if !ok {
+		return models.Game{}, domain.NotFound("game", id)
+	}
+	return g, nil
+}
+
+func (s memoryGames) FetchByTournament(_ context.Context, tournamentId uuid.UUID) ([]models.Game, error) {
+	var games []models.Game
+	for _, g := range s.games {
+		if g.TournamentID.UUID == tournamentId {
+			games = append(games, g)
+		}
+	}
+	sort.Slice(games, func(i, j int) bool {
+		if games[i].Round != games[j].Round {
+			return games[i].Round < games[j].Round
+		}
+		return games[i].Position < games[j].Position
+	})
+	return games, nil
+}
+
+// Update only changes the status, which is all results write.
+func (s memoryGames) Update(_ context.Context, updated *models.Game) error {
+	g := s.games[updated.GameID]
+	g.Status = updated.Status
+	s.games[g.GameID] = g
+	return nil
+}
+
+func (s memoryGames) Create(_ context.Context, g *models.Game) error {
+	s.games[g.GameID] = *g
+	return nil
+}
+
+func (s memoryGames) CreateMany(ctx context.Context, games []models.Game) error {
+	for i := range games {
+		if err := s.Create(ctx, &games[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s memoryGames) SetParticipant(_ context.Context, gameId uuid.UUID, p models.GameParticipant) error {
+	g := s.games[gameId]
+	g.Participants = append(g.Participants, p)
+	s.games[gameId] = g
+	return nil
+}
+
+type memoryResults struct {
+	repository.ResultsRepository
+	*memoryStore
+}
+
+func (s memoryResults) Create(_ context.Context, r *models.Result) error {
+	s.results[r.ResultID] = *r
+	return nil
+}
+
+func (s memoryResults) FetchByGameId(_ context.Context, gameId uuid.UUID) (models.Result, error) {
+	for _, r := range s.results {
+		if r.GameID == gameId {
+			return r, nil
+		}
+	}
+	return models.Result{}, domain.NotFound("result", gameId)
+}
+
+func (s memoryResults) FetchByTournament(_ context.Context, tournamentId uuid.UUID) ([]models.Result, error) {
+	var results []models.Result
+	for _, r := range s.results {
+		if s.games[r.GameID].TournamentID.UUID == tournamentId {
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+type memoryTournaments struct {
+	repository.TournamentsRepository
+	*memoryStore
+}
+
+func (s memoryTournaments) FetchById(_ context.Context, id uuid.UUID) (models.Tournament, error) {
+	t, ok := s.tournaments[id]
+	if !ok {
+		return models.Tournament{}, domain.NotFound("tournament", id)
+	}
+	return t, nil
+}
+
+func (s memoryTournaments) Update(_ context.Context, updated *models.Tournament) error {
+	t := s.tournaments[updated.TournamentID]
+	t.Status = updated.Status
+	s.tournaments[t.TournamentID] = t
+	return nil
+}
+
+// noReviews is a game nobody has reported yet.
+type noReviews struct {
+	repository.ResultReviewsRepository
+}
+
+func (noReviews) FetchByGame(_ context.Context, gameId uuid.UUID) (models.ResultReview, error) {
+	return models.ResultReview{}, domain.NotFound("result review", gameId)
+}
+
+type directTransactor struct{}
+
+func (directTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newMemoryResultsUseCase(s *memoryStore) *resultsUseCase {
+	return NewResultsUseCase(memoryResults{memoryStore: s}, memoryGames{memoryStore: s}, memoryTournaments{memoryStore: s},
+		nil, noReviews{}, nil, nil, nil, directTransactor{}, time.Second).(*resultsUseCase)
+}
+
+// startTournament stores the tournament with its first games live.
+func startTournament(s *memoryStore, t models.Tournament, games []models.Game) {
+	s.tournaments[t.TournamentID] = t
+	for _, g := range games {
+		g.Status = models.GameLive
+		s.games[g.GameID] = g
+	}
+}
+
+// win records that the participant in the slot won the game.
+func win(t *testing.T, ru *resultsUseCase, game models.Game, slot int) {
+	t.Helper()
+	r := &models.Result{ResultID: uuid.New(), GameID: game.GameID, WinnerID: game.Participants[slot].ParticipantID}
+	if err := ru.Create(context.Background(), r); err != nil {
+		t.Fatalf("Create() result of game %d/%d error = %v", game.Round, game.Position, err)
+	}
+}
+
+func roundGames(s *memoryStore, bracket models.BracketSide, round int) []models.Game {
+	var games []models.Game
+	for _, g := range s.games {
+		if g.Bracket == bracket && g.Round == round {
+			games = append(games, g)
+		}
+	}
+	sort.Slice(games, func(i, j int) bool { return games[i].Position < games[j].Position })
+	return games
+}
+
+func pairing(g models.Game) [2]uuid.UUID {
+	var p [2]uuid.UUID
+	for _, gp := range g.Participants {
+		p[gp.Slot] = gp.ParticipantID
+	}
+	return p
+}
+
+func testSeeds(n int) []uuid.UUID {
+	seeds := make([]uuid.UUID, n)
+	for i := range seeds {
+		seeds[i] = uuid.New()
+	}
+	return seeds
+}
+
+func TestRecordingLastSwissResultPairsNextRound(t *testing.T) {
+	s := newMemoryStore()
+	ru := newMemoryResultsUseCase(s)
+
+	tournament := models.Tournament{
+		TournamentID: uuid.New(),
+		Format:       models.FormatSwiss,
+		StartsAt:     time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+		SlotLength:   time.Hour,
+	}
+	seeds := testSeeds(4)
+	first, err := SwissFirstRound(tournament, seeds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	startTournament(s, tournament, first)
+
+	// Seeds 1-3 and 2-4 meet, the lower seeds win both games.
+	win(t, ru, first[0], 1)
+	if got := roundGames(s, models.BracketSwiss, 2); len(got) != 0 {
+		t.Fatalf("round 2 was paired with a round 1 game undecided: %v", got)
+	}
+	win(t, ru, first[1], 1)
+
+	next := roundGames(s, models.BracketSwiss, 2)
+	want := [][2]uuid.UUID{{seeds[2], seeds[3]}, {seeds[0], seeds[1]}}
+	if len(next) != len(want) {
+		t.Fatalf("round 2 has %d games, want %d", len(next), len(want))
+	}
+	for i, g := range next {
+		if got := pairing(g); got != want[i] {
+			t.Errorf("round 2 game %d pairs %v, want %v", i, got, want[i])
+		}
+		if wantStart := tournament.StartsAt.Add(time.Hour); !g.GameStart.Equal(wantStart) {
+			t.Errorf("round 2 game %d starts at %v, want %v", i, g.GameStart, wantStart)
+		}
+	}
+}
+
+func TestRecordingLastGroupResultSeedsPlayoff(t *testing.T) {
+	s := newMemoryStore()
+	ru := newMemoryResultsUseCase(s)
+
+	tournament := models.Tournament{
+		TournamentID: uuid.New(),
+		Format:       models.FormatGroupStage,
+		StartsAt:     time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+		SlotLength:   time.Hour,
+		Groups:       2,
+		GroupAdvance: 2,
+	}
+	// Snake seeding puts seeds 1 and 4 into group 1, seeds 2 and 3 into
+	// group 2.
+	seeds := testSeeds(4)
+	groups, err := GroupStage(tournament, seeds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	startTournament(s, tournament, groups)
+
+	byGroup := make(map[int]models.Game)
+	for _, g := range groups {
+		byGroup[g.Group] = g
+	}
+	winner := func(g models.Game, id uuid.UUID) int {
+		for _, p := range g.Participants {
+			if p.ParticipantID == id {
+				return p.Slot
+			}
+		}
+		t.Fatalf("%s does not play in group %d", id, g.Group)
+		return 0
+	}
+
+	// Seed 4 tops group 1, seed 2 tops group 2.
+	win(t, ru, byGroup[1], winner(byGroup[1], seeds[3]))
+	win(t, ru, byGroup[2], winner(byGroup[2], seeds[1]))
+
+	playoff := roundGames(s, models.BracketWinners, 1)
+	// Group winners are seeded first: seed 4, seed 2, then the runners-up
+	// seed 1 and seed 3. The best group winner meets the worst runner-up.
+	want := [][2]uuid.UUID{{seeds[3], seeds[2]}, {seeds[1], seeds[0]}}
+	if len(playoff) != len(want) {
+		t.Fatalf("playoff round 1 has %d games, want %d", len(playoff), len(want))
+	}
+	for i, g := range playoff {
+		if got := pairing(g); got != want[i] {
+			t.Errorf("playoff game %d pairs %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestCheckResult(t *testing.T) {
+	s := newMemoryStore()
+	ru := newMemoryResultsUseCase(s)
+
+	p := testSeeds(5)
+	stranger := p[4]
+	newGame := func(bracket models.BracketSide, participants ...uuid.UUID) uuid.UUID {
+		g := models.Game{GameID: uuid.New(), Bracket: bracket}
+		for slot, id := range participants {
+			g.Participants = append(g.Participants, models.GameParticipant{ParticipantID: id, Slot: slot})
+		}
+		s.games[g.GameID] = g
+		return g.GameID
+	}
+	duel := newGame("", p[0], p[1])
+	elimination := newGame(models.BracketWinners, p[0], p[1])
+	freeForAll := newGame("", p[0], p[1], p[2], p[3])
+	forfeited := func(id uuid.UUID) uuid.NullUUID { return uuid.NullUUID{UUID: id, Valid: true} }
+	places := func(ids []uuid.UUID, places ...int) []models.Placement {
+		placements := make([]models.Placement, len(places))
+		for i, place := range places {
+			placements[i] = models.Placement{ParticipantID: ids[i], Place: place}
+		}
+		return placements
+	}
+
+	tests := []struct {
+		name   string
+		result models.Result
+		// wantPlaced lists the participants placed, in order, when the
+		// result is accepted.
+		wantPlaced []uuid.UUID
+		wantWinner uuid.UUID
+		wantErr    bool
+	}{
+		{
+			name:       "winner only",
+			result:     models.Result{GameID: duel, WinnerID: p[1]},
+			wantPlaced: []uuid.UUID{p[1]},
+			wantWinner: p[1],
+		},
+		{
+			name:       "placements name the winner",
+			result:     models.Result{GameID: freeForAll, Placements: places([]uuid.UUID{p[2], p[0], p[1], p[3]}, 2, 1, 2, 4)},
+			wantPlaced: []uuid.UUID{p[0], p[2], p[1], p[3]},
+			wantWinner: p[0],
+		},
+		{
+			name:       "shared first place",
+			result:     models.Result{GameID: freeForAll, Placements: places(p, 1, 1, 3)},
+			wantPlaced: p[:3],
+			wantWinner: p[0],
+		},
+		{
+			name:    "place skipped",
+			result:  models.Result{GameID: freeForAll, Placements: places(p, 1, 3)},
+			wantErr: true,
+		},
+		{
+			name:    "place not skipped after a tie",
+			result:  models.Result{GameID: freeForAll, Placements: places(p, 1, 2, 2, 3)},
+			wantErr: true,
+		},
+		{
+			name:    "placed twice",
+			result:  models.Result{GameID: freeForAll, Placements: places([]uuid.UUID{p[0], p[0]}, 1, 2)},
+			wantErr: true,
+		},
+		{
+			name:    "stranger placed",
+			result:  models.Result{GameID: duel, Placements: places([]uuid.UUID{stranger}, 1)},
+			wantErr: true,
+		},
+		{
+			name:    "winner not placed first",
+			result:  models.Result{GameID: duel, WinnerID: p[1], Placements: places(p, 1, 2)},
+			wantErr: true,
+		},
+		{
+			name:    "neither winner nor placements",
+			result:  models.Result{GameID: duel},
+			wantErr: true,
+		},
+		{
+			name:       "draw places everyone first",
+			result:     models.Result{GameID: duel, Outcome: models.OutcomeDraw},
+			wantPlaced: p[:2],
+		},
+		{
+			name:    "draw with a winner",
+			result:  models.Result{GameID: duel, Outcome: models.OutcomeDraw, WinnerID: p[0]},
+			wantErr: true,
+		},
+		{
+			name: "draw with unequal scores",
+			result: models.Result{GameID: duel, Outcome: models.OutcomeDraw, Placements: []models.Placement{
+				{ParticipantID: p[0], Place: 1, Score: 2},
+				{ParticipantID: p[1], Place: 1, Score: 1},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "draw in an elimination game",
+			result:  models.Result{GameID: elimination, Outcome: models.OutcomeDraw},
+			wantErr: true,
+		},
+		{
+			name:       "forfeit hands the duel to the other side",
+			result:     models.Result{GameID: duel, Outcome: models.OutcomeForfeit, ForfeitedBy: forfeited(p[0])},
+			wantPlaced: []uuid.UUID{p[1], p[0]},
+			wantWinner: p[1],
+		},
+		{
+			name:       "disqualification hands the duel to the other side",
+			result:     models.Result{GameID: elimination, Outcome: models.OutcomeDisqualification, ForfeitedBy: forfeited(p[1])},
+			wantPlaced: []uuid.UUID{p[0], p[1]},
+			wantWinner: p[0],
+		},
+		{
+			name:    "forfeit to the wrong winner",
+			result:  models.Result{GameID: duel, Outcome: models.OutcomeForfeit, ForfeitedBy: forfeited(p[0]), WinnerID: p[0]},
+			wantErr: true,
+		},
+		{
+			name:    "forfeit without who forfeited",
+			result:  models.Result{GameID: duel, Outcome: models.OutcomeForfeit, WinnerID: p[0]},
+			wantErr: true,
+		},
+		{
+			name:    "forfeit by a stranger",
+			result:  models.Result{GameID: duel, Outcome: models.OutcomeForfeit, ForfeitedBy: forfeited(stranger)},
+			wantErr: true,
+		},
+		{
+			name:    "win records who forfeited",
+			result:  models.Result{GameID: duel, WinnerID: p[0], ForfeitedBy: forfeited(p[1])},
+			wantErr: true,
+		},
+		{
+			name:   "cancelled",
+			result: models.Result{GameID: duel, Outcome: models.OutcomeCancelled},
+		},
+		{
+			name:    "cancelled with a winner",
+			result:  models.Result{GameID: duel, Outcome: models.OutcomeCancelled, WinnerID: p[0]},
+			wantErr: true,
+		},
+		{
+			name:    "unknown outcome",
+			result:  models.Result{GameID: duel, Outcome: "abandoned", WinnerID: p[0]},
+			wantErr: true,
+		},
+		{
+			name:    "unknown game",
+			result:  models.Result{GameID: uuid.New(), WinnerID: p[0]},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.result
+			_, err := ru.checkResult(context.Background(), &r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("checkResult() accepted %+v", tt.result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkResult() error = %v", err)
+			}
+
+			if r.WinnerID != tt.wantWinner {
+				t.Errorf("winner = %s, want %s", r.WinnerID, tt.wantWinner)
+			}
+			if len(r.Placements) != len(tt.wantPlaced) {
+				t.Fatalf("placements = %+v, want %v placed", r.Placements, tt.wantPlaced)
+			}
+			for i, p := range r.Placements {
+				if p.ParticipantID != tt.wantPlaced[i] {
+					t.Errorf("placement %d = %s, want %s", i, p.ParticipantID, tt.wantPlaced[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGrandFinalReset(t *testing.T) {
+	tests := []struct {
+		name string
+		// winnerSlot takes the grand final, slot 1 is the losers bracket
+		// finalist.
+		winnerSlot int
+		reset      bool
+	}{
+		{name: "winners bracket finalist takes the title", winnerSlot: 0},
+		{name: "losers bracket finalist forces a reset", winnerSlot: 1, reset: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newMemoryStore()
+			ru := newMemoryResultsUseCase(s)
+
+			tournament := testTournament(models.FormatDoubleElimination)
+			tournament.GrandFinalReset = true
+			games, err := DoubleEliminationBracket(tournament, testSeeds(2))
+			if err != nil {
+				t.Fatal(err)
+			}
+			startTournament(s, tournament, games)
+
+			win(t, ru, roundGames(s, models.BracketWinners, 1)[0], 0)
+			final := roundGames(s, models.BracketGrandFinal, 1)[0]
+			win(t, ru, final, tt.winnerSlot)
+
+			resets := roundGames(s, models.BracketGrandFinal, 2)
+			if !tt.reset {
+				if len(resets) != 0 {
+					t.Fatalf("grand final was reset after slot %d won", tt.winnerSlot)
+				}
+				if status := s.tournaments[tournament.TournamentID].Status; status != models.TournamentFinished {
+					t.Errorf("tournament is %s, want finished", status)
+				}
+				return
+			}
+
+			if len(resets) != 1 {
+				t.Fatalf("got %d reset games, want 1", len(resets))
+			}
+			reset := resets[0]
+			if pairing(reset) != pairing(final) {
+				t.Errorf("reset pairs %v, want the grand finalists %v", pairing(reset), pairing(final))
+			}
+			if want := final.GameStart.Add(tournament.SlotLength); !reset.GameStart.Equal(want) {
+				t.Errorf("reset starts at %v, want %v", reset.GameStart, want)
+			}
+			if status := s.tournaments[tournament.TournamentID].Status; status == models.TournamentFinished {
+				t.Fatal("tournament finished before the reset was played")
+			}
+
+			reset.Status = models.GameLive
+			s.games[reset.GameID] = reset
+			win(t, ru, reset, 1)
+			if status := s.tournaments[tournament.TournamentID].Status; status != models.TournamentFinished {
+				t.Errorf("tournament is %s after the reset, want finished", status)
+			}
+			if n := len(roundGames(s, models.BracketGrandFinal, 3)); n != 0 {
+				t.Errorf("got %d games after the reset, want none", n)
+			}
+		})
+	}
+}
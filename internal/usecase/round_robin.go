@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"sort"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// RoundRobinSchedule pairs everyone with everyone using the circle method:
+// the first participant stays in place while the others rotate around it.
+// An odd field gets a phantom participant, whoever meets it sits the round
+// out. Every leg after the first repeats the schedule with slots swapped.
+// Rounds start SlotLength apart from the tournament start.
+func RoundRobinSchedule(t models.Tournament, participants []uuid.UUID, group int) ([]models.Game, error) {
+	if len(participants) < 2 {
+		return nil, domain.InvalidArgument("round robin needs at least 2 participants, got %d", len(participants))
+	}
+
+	legs := t.Legs
+	if legs < 1 {
+		legs = 1
+	}
+
+	circle := make([]uuid.NullUUID, 0, len(participants)+1)
+	for _, p := range participants {
+		circle = append(circle, uuid.NullUUID{UUID: p, Valid: true})
+	}
+	if len(circle)%2 == 1 {
+		circle = append(circle, uuid.NullUUID{})
+	}
+	n := len(circle)
+
+	var games []models.Game
+	for leg := 0; leg < legs; leg++ {
+		rotation := append([]uuid.NullUUID(nil), circle...)
+		for r := 0; r < n-1; r++ {
+			round := leg*(n-1) + r + 1
+			position := 0
+			for i := 0; i < n/2; i++ {
+				home, away := rotation[i], rotation[n-1-i]
+				if !home.Valid || !away.Valid {
+					continue
+				}
+				// Alternate sides of the fixed participant so it does
+				// not take slot 0 in every round.
+				if (i == 0 && r%2 == 1) != (leg%2 == 1) {
+					home, away = away, home
+				}
+
+				g := newBracketGame(t, models.BracketGroup, round, position)
+				g.Group = group
+				g.GameStart = t.StartsAt.Add(time.Duration(round-1) * t.SlotLength)
+				g.Participants = []models.GameParticipant{
+					{ParticipantID: home.UUID, Slot: 0},
+					{ParticipantID: away.UUID, Slot: 1},
+				}
+				games = append(games, g)
+				position++
+			}
+			last := rotation[n-1]
+			copy(rotation[2:], rotation[1:n-1])
+			rotation[1] = last
+		}
+	}
+
+	return games, nil
+}
+
+// GroupStage splits seeds into t.Groups groups snake-wise, so every group
+// gets a similar spread of strength, and schedules a round robin in each.
+// Groups are numbered from 1.
+func GroupStage(t models.Tournament, seeds []uuid.UUID) ([]models.Game, error) {
+	groups := t.Groups
+	if groups < 1 {
+		return nil, domain.InvalidArgument("group stage needs at least 1 group, got %d", groups)
+	}
+	if t.GroupAdvance < 1 || groups*t.GroupAdvance < 2 {
+		return nil, domain.InvalidArgument("group stage must advance at least 2 participants to the playoff")
+	}
+	if len(seeds) < groups*2 {
+		return nil, domain.InvalidArgument("%d participants are not enough for %d groups", len(seeds), groups)
+	}
+
+	members := make([][]uuid.UUID, groups)
+	for i, seed := range seeds {
+		group := i % groups
+		if (i/groups)%2 == 1 {
+			group = groups - 1 - group
+		}
+		members[group] = append(members[group], seed)
+	}
+
+	for i, m := range members {
+		if len(m) < t.GroupAdvance {
+			return nil, domain.InvalidArgument("group %d has %d participants, fewer than the %d advancing", i+1, len(m), t.GroupAdvance)
+		}
+	}
+
+	var games []models.Game
+	for i, m := range members {
+		schedule, err := RoundRobinSchedule(t, m, i+1)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, schedule...)
+	}
+
+	return games, nil
+}
+
+// groupRow is a participant's line in a group table.
+type groupRow struct {
+	participant uuid.UUID
+	points      float64
+}
+
+// rankGroup orders the participants of a group by points. Participants tied
+// on points are ordered by the points they took from each other, then by id
+// so the order is stable.
+func rankGroup(games []models.Game, results map[uuid.UUID]models.Result) []uuid.UUID {
+	rows := make(map[uuid.UUID]*groupRow)
+	for _, g := range games {
+		for _, p := range g.Participants {
+			if rows[p.ParticipantID] == nil {
+				rows[p.ParticipantID] = &groupRow{participant: p.ParticipantID}
+			}
+			if r, ok := results[g.GameID]; ok {
+				rows[p.ParticipantID].points += points(r, p.ParticipantID)
+			}
+		}
+	}
+
+	headToHead := func(a, b uuid.UUID) float64 {
+		var total float64
+		for _, g := range games {
+			var hasA, hasB bool
+			for _, p := range g.Participants {
+				hasA = hasA || p.ParticipantID == a
+				hasB = hasB || p.ParticipantID == b
+			}
+			if r, ok := results[g.GameID]; ok && hasA && hasB {
+				total += points(r, a)
+			}
+		}
+		return total
+	}
+
+	ranked := make([]uuid.UUID, 0, len(rows))
+	for id := range rows {
+		ranked = append(ranked, id)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := rows[ranked[i]], rows[ranked[j]]
+		if a.points != b.points {
+			return a.points > b.points
+		}
+		if ha, hb := headToHead(a.participant, b.participant), headToHead(b.participant, a.participant); ha != hb {
+			return ha > hb
+		}
+		return a.participant.String() < b.participant.String()
+	})
+
+	return ranked
+}
+
+// PlayoffSeeds ranks every group and seeds the top t.GroupAdvance of each
+// into the playoff: all group winners first, then all runners-up and so on.
+func PlayoffSeeds(t models.Tournament, games []models.Game, results map[uuid.UUID]models.Result) []uuid.UUID {
+	byGroup := make(map[int][]models.Game)
+	for _, g := range games {
+		if g.Bracket == models.BracketGroup {
+			byGroup[g.Group] = append(byGroup[g.Group], g)
+		}
+	}
+
+	tables := make([][]uuid.UUID, 0, len(byGroup))
+	for group := 1; group <= len(byGroup); group++ {
+		tables = append(tables, rankGroup(byGroup[group], results))
+	}
+
+	var seeds []uuid.UUID
+	for place := 0; place < t.GroupAdvance; place++ {
+		for _, table := range tables {
+			if place < len(table) {
+				seeds = append(seeds, table[place])
+			}
+		}
+	}
+
+	return seeds
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"testing"
+	"time"
+	"tournaments-core/internal/domain/models"
+)
+
+func TestRoundRobinSchedule(t *testing.T) {
+	tests := []struct {
+		participants int
+		legs         int
+		rounds       int
+	}{
+		{participants: 2, legs: 1, rounds: 1},
+		{participants: 3, legs: 1, rounds: 3},
+		{participants: 4, legs: 1, rounds: 3},
+		{participants: 5, legs: 1, rounds: 5},
+		{participants: 6, legs: 1, rounds: 5},
+		{participants: 4, legs: 2, rounds: 6},
+		{participants: 5, legs: 2, rounds: 10},
+	}
+
+	for _, tt := range tests {
+		tournament := testTournament(models.FormatRoundRobin)
+		tournament.Legs = tt.legs
+		participants := testSeeds(tt.participants)
+		games, err := RoundRobinSchedule(tournament, participants, 1)
+		if err != nil {
+			t.Fatalf("%d participants, %d legs: RoundRobinSchedule() error = %v", tt.participants, tt.legs, err)
+		}
+
+		// home counts the games each participant hosted against each
+		// opponent, every leg swaps the slots of the one before.
+		home := make(map[[2]uuid.UUID]int)
+		inRound := make(map[int]map[uuid.UUID]bool)
+		lastRound := 0
+		for _, g := range games {
+			p := pairing(g)
+			home[p]++
+			if inRound[g.Round] == nil {
+				inRound[g.Round] = make(map[uuid.UUID]bool)
+			}
+			for _, id := range p {
+				if inRound[g.Round][id] {
+					t.Errorf("%d participants, %d legs: %s plays twice in round %d", tt.participants, tt.legs, id, g.Round)
+				}
+				inRound[g.Round][id] = true
+			}
+			lastRound = max(lastRound, g.Round)
+
+			wantStart := tournament.StartsAt.Add(time.Duration(g.Round-1) * time.Hour)
+			if g.Group != 1 || g.Bracket != models.BracketGroup || !g.GameStart.Equal(wantStart) {
+				t.Errorf("%d participants, %d legs: game %d/%d is in group %d of %s starting at %v, want group 1 starting at %v",
+					tt.participants, tt.legs, g.Round, g.Position, g.Group, g.Bracket, g.GameStart, wantStart)
+			}
+		}
+
+		if lastRound != tt.rounds {
+			t.Errorf("%d participants, %d legs: got %d rounds, want %d", tt.participants, tt.legs, lastRound, tt.rounds)
+		}
+		for i, a := range participants {
+			for _, b := range participants[i+1:] {
+				ab, ba := home[[2]uuid.UUID{a, b}], home[[2]uuid.UUID{b, a}]
+				if ab+ba != tt.legs {
+					t.Errorf("%d participants, %d legs: %s and %s meet %d times, want %d", tt.participants, tt.legs, a, b, ab+ba, tt.legs)
+				}
+				if tt.legs == 2 && (ab != 1 || ba != 1) {
+					t.Errorf("%d participants, 2 legs: %s hosts %s %d times and is hosted %d times, want once each", tt.participants, a, b, ab, ba)
+				}
+			}
+		}
+		// With an odd field everyone sits out once per leg.
+		if tt.participants%2 == 1 {
+			for _, p := range participants {
+				sat := 0
+				for round := 1; round <= lastRound; round++ {
+					if !inRound[round][p] {
+						sat++
+					}
+				}
+				if sat != tt.legs {
+					t.Errorf("%d participants, %d legs: %s sits out %d rounds, want %d", tt.participants, tt.legs, p, sat, tt.legs)
+				}
+			}
+		}
+	}
+}
+
+func TestRoundRobinScheduleRotatesTheCircle(t *testing.T) {
+	p := testSeeds(4)
+	games, err := RoundRobinSchedule(testTournament(models.FormatRoundRobin), p, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first participant stays put and alternates sides, the others
+	// rotate clockwise around it.
+	want := map[int][][2]uuid.UUID{
+		1: {{p[0], p[3]}, {p[1], p[2]}},
+		2: {{p[2], p[0]}, {p[3], p[1]}},
+		3: {{p[0], p[1]}, {p[2], p[3]}},
+	}
+	for round, pairs := range want {
+		for position, pair := range pairs {
+			if got := pairing(findGame(t, games, models.BracketGroup, round, position)); got != pair {
+				t.Errorf("round %d game %d pairs %v, want %v", round, position, got, pair)
+			}
+		}
+	}
+}
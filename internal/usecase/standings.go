@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"encoding/binary"
+	"github.com/google/uuid"
+	"hash/fnv"
+	"sort"
+	"tournaments-core/internal/domain/models"
+)
+
+// standingRecord is a participant's row along with the games behind it, which
+// head-to-head needs.
+type standingRecord struct {
+	row    models.StandingRow
+	played []playedGame
+}
+
+// playedGame is a decided game as one participant saw it.
+type playedGame struct {
+	opponents []uuid.UUID
+	points    float64
+}
+
+// Standings ranks the participants of the games by the points the rules give
+// for their results, then by the rules' tiebreaks in order. Participants level
+// after every tiebreak share a rank and are ordered by id. seed picks the
+// draw of lots of the random tiebreak.
+func Standings(games []models.Game, results map[uuid.UUID]models.Result, rules models.StandingsRules, seed uuid.UUID) []models.StandingRow {
+	records := standingRecords(games, results, rules)
+
+	ranked := make([]*standingRecord, 0, len(records))
+	for _, r := range records {
+		ranked = append(ranked, r)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return ranked[i].row.ParticipantID.String() < ranked[j].row.ParticipantID.String()
+	})
+
+	tied := splitTies([][]*standingRecord{ranked}, func(r *standingRecord, _ []*standingRecord) float64 {
+		return r.row.Points
+	})
+	for _, t := range rules.Tiebreaks {
+		tied = splitTies(tied, tiebreakKey(t, seed))
+	}
+
+	rows := make([]models.StandingRow, 0, len(ranked))
+	for _, group := range tied {
+		rank := len(rows) + 1
+		for _, r := range group {
+			r.row.Rank = rank
+			rows = append(rows, r.row)
+		}
+	}
+	return rows
+}
+
+func standingRecords(games []models.Game, results map[uuid.UUID]models.Result, rules models.StandingsRules) map[uuid.UUID]*standingRecord {
+	records := make(map[uuid.UUID]*standingRecord)
+	record := func(id uuid.UUID) *standingRecord {
+		if records[id] == nil {
+			records[id] = &standingRecord{row: models.StandingRow{ParticipantID: id}}
+		}
+		return records[id]
+	}
+
+	for _, g := range games {
+		for _, p := range g.Participants {
+			record(p.ParticipantID)
+		}
+
+		if len(g.Participants) == 1 && g.Bracket == models.BracketSwiss {
+			r := record(g.Participants[0].ParticipantID)
+			r.row.Played++
+			r.row.Wins++
+			r.row.Points += rules.Win
+			r.played = append(r.played, playedGame{points: rules.Win})
+			continue
+		}
+
+		result, decided := results[g.GameID]
+		if !decided || result.Outcome == models.OutcomeCancelled || len(g.Participants) < 2 {
+			continue
+		}
+
+		scores := make(map[uuid.UUID]float64, len(result.Placements))
+		var total float64
+		for _, p := range result.Placements {
+			scores[p.ParticipantID] = p.Score
+			total += p.Score
+		}
+		winners := result.Winners()
+
+		for _, p := range g.Participants {
+			r := record(p.ParticipantID)
+			game := playedGame{}
+			for _, o := range g.Participants {
+				if o.ParticipantID != p.ParticipantID {
+					game.opponents = append(game.opponents, o.ParticipantID)
+				}
+			}
+
+			switch {
+			case result.Outcome == models.OutcomeDraw:
+				r.row.Draws++
+				game.points = rules.Draw
+			case contains(winners, p.ParticipantID):
+				r.row.Wins++
+				game.points = rules.Win
+			default:
+				r.row.Losses++
+				game.points = rules.Loss
+			}
+
+			r.row.Played++
+			r.row.Points += game.points
+			r.row.ScoreFor += scores[p.ParticipantID]
+			r.row.ScoreAgainst += total - scores[p.ParticipantID]
+			r.played = append(r.played, game)
+		}
+	}
+
+	for _, r := range records {
+		r.row.ScoreDiff = r.row.ScoreFor - r.row.ScoreAgainst
+		for _, g := range r.played {
+			for _, o := range g.opponents {
+				r.row.Buchholz += records[o].row.Points
+			}
+		}
+	}
+
+	return records
+}
+
+// tieKey is the value a tiebreak ranks a participant by, higher first. It is
+// given the participants tied with it, head-to-head only looks at their
+// games.
+type tieKey func(r *standingRecord, tied []*standingRecord) float64
+
+func tiebreakKey(t models.Tiebreak, seed uuid.UUID) tieKey {
+	switch t {
+	case models.TiebreakHeadToHead:
+		return headToHead
+	case models.TiebreakBuchholz:
+		return func(r *standingRecord, _ []*standingRecord) float64 { return r.row.Buchholz }
+	case models.TiebreakScoreDiff:
+		return func(r *standingRecord, _ []*standingRecord) float64 { return r.row.ScoreDiff }
+	case models.TiebreakRandom:
+		return func(r *standingRecord, _ []*standingRecord) float64 { return drawLot(seed, r.row.ParticipantID) }
+	}
+	return func(*standingRecord, []*standingRecord) float64 { return 0 }
+}
+
+// headToHead is the points a participant took from games against the others
+// it is tied with.
+func headToHead(r *standingRecord, tied []*standingRecord) float64 {
+	var total float64
+	for _, g := range r.played {
+		for _, t := range tied {
+			if t != r && contains(g.opponents, t.row.ParticipantID) {
+				total += g.points
+				break
+			}
+		}
+	}
+	return total
+}
+
+// drawLot gives every participant a number that only depends on the seed, so
+// a table is ordered the same way every time it is computed.
+func drawLot(seed, participant uuid.UUID) float64 {
+	h := fnv.New64a()
+	h.Write(seed[:])
+	h.Write(participant[:])
+	// Keep 53 bits so the number is exact as a float64.
+	return float64(binary.BigEndian.Uint64(h.Sum(nil)) >> 11)
+}
+
+// splitTies orders every group of tied participants by key and splits it
+// where the key differs. Groups keep their order.
+func splitTies(groups [][]*standingRecord, key tieKey) [][]*standingRecord {
+	split := make([][]*standingRecord, 0, len(groups))
+	for _, group := range groups {
+		if len(group) == 1 {
+			split = append(split, group)
+			continue
+		}
+
+		keys := make(map[*standingRecord]float64, len(group))
+		for _, r := range group {
+			keys[r] = key(r, group)
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			return keys[group[i]] > keys[group[j]]
+		})
+
+		start := 0
+		for i := 1; i <= len(group); i++ {
+			if i == len(group) || keys[group[i]] != keys[group[start]] {
+				split = append(split, group[start:i])
+				start = i
+			}
+		}
+	}
+	return split
+}
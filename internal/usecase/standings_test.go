@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"sort"
+	"testing"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// scoredGames plays a group in which A, B and C beat each other in a circle
+// and all beat D:
+//
+//	A-B 2:1, B-C 3:0, C-A 1:0, A-D 1:0, B-D 1:0, C-D 5:0
+//
+// A, B and C take six points each and one another's head-to-head is level.
+// On score difference A has +1, B and C +3 each, and B beat C.
+func scoredGames(tournamentId uuid.UUID) (a, b, c, d uuid.UUID, games []models.Game, results map[uuid.UUID]models.Result) {
+	ids := testSeeds(4)
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	// A sorts after C, so an unbroken tie does not put the circle in order.
+	c, b, a, d = ids[0], ids[1], ids[2], ids[3]
+
+	results = make(map[uuid.UUID]models.Result)
+	play := func(x uuid.UUID, sx float64, y uuid.UUID, sy float64) {
+		g := models.Game{
+			GameID:       uuid.New(),
+			TournamentID: uuid.NullUUID{UUID: tournamentId, Valid: true},
+			Bracket:      models.BracketGroup,
+			Group:        1,
+			Round:        len(games) + 1,
+			Participants: []models.GameParticipant{{ParticipantID: x, Slot: 0}, {ParticipantID: y, Slot: 1}},
+		}
+		games = append(games, g)
+		results[g.GameID] = models.Result{
+			ResultID: uuid.New(),
+			GameID:   g.GameID,
+			WinnerID: x,
+			Outcome:  models.OutcomeWin,
+			Placements: []models.Placement{
+				{ParticipantID: x, Place: 1, Score: sx},
+				{ParticipantID: y, Place: 2, Score: sy},
+			},
+		}
+	}
+	play(a, 2, b, 1)
+	play(b, 3, c, 0)
+	play(c, 1, a, 0)
+	play(a, 1, d, 0)
+	play(b, 1, d, 0)
+	play(c, 5, d, 0)
+	return a, b, c, d, games, results
+}
+
+func TestStandingsTiebreaks(t *testing.T) {
+	tournamentId := uuid.New()
+	a, b, c, d, games, results := scoredGames(tournamentId)
+	names := map[uuid.UUID]string{a: "A", b: "B", c: "C", d: "D"}
+
+	type row struct {
+		name string
+		rank int
+	}
+	tests := []struct {
+		name      string
+		tiebreaks []models.Tiebreak
+		want      []row
+	}{
+		{
+			name: "points only",
+			want: []row{{"C", 1}, {"B", 1}, {"A", 1}, {"D", 4}},
+		},
+		{
+			name:      "level head-to-head",
+			tiebreaks: []models.Tiebreak{models.TiebreakHeadToHead},
+			want:      []row{{"C", 1}, {"B", 1}, {"A", 1}, {"D", 4}},
+		},
+		{
+			name:      "head-to-head then score difference",
+			tiebreaks: []models.Tiebreak{models.TiebreakHeadToHead, models.TiebreakScoreDiff},
+			want:      []row{{"C", 1}, {"B", 1}, {"A", 3}, {"D", 4}},
+		},
+		{
+			// Head-to-head only looks at the games of the participants still
+			// tied, B and C.
+			name:      "score difference then head-to-head",
+			tiebreaks: []models.Tiebreak{models.TiebreakScoreDiff, models.TiebreakHeadToHead},
+			want:      []row{{"B", 1}, {"C", 2}, {"A", 3}, {"D", 4}},
+		},
+		{
+			name:      "level buchholz",
+			tiebreaks: []models.Tiebreak{models.TiebreakBuchholz, models.TiebreakScoreDiff, models.TiebreakHeadToHead},
+			want:      []row{{"B", 1}, {"C", 2}, {"A", 3}, {"D", 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := models.StandingsRules{Win: 3, Draw: 1, Tiebreaks: tt.tiebreaks}
+			rows := Standings(games, results, rules, tournamentId)
+
+			got := make([]row, 0, len(rows))
+			for _, r := range rows {
+				got = append(got, row{names[r.ParticipantID], r.Rank})
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Standings() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Standings() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestStandingsRows(t *testing.T) {
+	tournamentId := uuid.New()
+	a, _, _, d, games, results := scoredGames(tournamentId)
+	rows := Standings(games, results, models.DefaultStandingsRules, tournamentId)
+
+	byId := make(map[uuid.UUID]models.StandingRow)
+	for _, r := range rows {
+		byId[r.ParticipantID] = r
+	}
+	wantA := models.StandingRow{
+		Rank: byId[a].Rank, ParticipantID: a, Played: 3, Wins: 2, Losses: 1, Points: 6,
+		ScoreFor: 3, ScoreAgainst: 2, ScoreDiff: 1, Buchholz: 12,
+	}
+	if byId[a] != wantA {
+		t.Errorf("row of A = %+v, want %+v", byId[a], wantA)
+	}
+	wantD := models.StandingRow{
+		Rank: 4, ParticipantID: d, Played: 3, Losses: 3, ScoreAgainst: 7, ScoreDiff: -7, Buchholz: 18,
+	}
+	if byId[d] != wantD {
+		t.Errorf("row of D = %+v, want %+v", byId[d], wantD)
+	}
+}
+
+func TestStandingsRandomTiebreakIsStable(t *testing.T) {
+	tournamentId := uuid.New()
+	_, _, _, d, games, results := scoredGames(tournamentId)
+	rules := models.StandingsRules{Win: 3, Draw: 1, Tiebreaks: []models.Tiebreak{models.TiebreakRandom}}
+
+	first := Standings(games, results, rules, tournamentId)
+	for i, r := range first {
+		if r.Rank != i+1 {
+			t.Errorf("row %d has rank %d, the draw of lots leaves no ties", i, r.Rank)
+		}
+	}
+	if first[3].ParticipantID != d {
+		t.Errorf("last row is %s, the draw of lots only orders participants level on points", first[3].ParticipantID)
+	}
+
+	again := Standings(games, results, rules, tournamentId)
+	for i := range first {
+		if first[i] != again[i] {
+			t.Fatalf("the same seed ranked %v, then %v", first, again)
+		}
+	}
+}
+
+func TestStandingsPageToken(t *testing.T) {
+	s := newMemoryStore()
+	tournament := testTournament(models.FormatRoundRobin)
+	s.tournaments[tournament.TournamentID] = tournament
+
+	_, _, _, _, games, results := scoredGames(tournament.TournamentID)
+	for _, g := range games {
+		s.games[g.GameID] = g
+	}
+	for _, r := range results {
+		s.results[r.ResultID] = r
+	}
+	su := NewStandingsUseCase(memoryTournaments{memoryStore: s}, memoryGames{memoryStore: s}, memoryResults{memoryStore: s}, time.Second)
+
+	query := models.StandingsQuery{
+		TournamentID: tournament.TournamentID,
+		Rules:        models.DefaultStandingsRules,
+		Page:         models.PageRequest{Size: 3},
+	}
+	full, err := su.Standings(context.Background(), models.StandingsQuery{TournamentID: tournament.TournamentID, Rules: query.Rules})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := su.Standings(context.Background(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Rows) != 3 || first.Total != 4 || first.NextPageToken == "" {
+		t.Fatalf("first page = %d rows of %d, next %q, want 3 of 4 and a token", len(first.Rows), first.Total, first.NextPageToken)
+	}
+
+	query.Page.Token = first.NextPageToken
+	second, err := su.Standings(context.Background(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.Rows) != 1 || second.NextPageToken != "" {
+		t.Fatalf("second page = %d rows, next %q, want 1 row and no token", len(second.Rows), second.NextPageToken)
+	}
+	for i, r := range append(first.Rows, second.Rows...) {
+		if r != full.Rows[i] {
+			t.Errorf("paged row %d = %+v, want %+v", i, r, full.Rows[i])
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query func(q models.StandingsQuery) models.StandingsQuery
+	}{
+		{
+			name: "malformed",
+			query: func(q models.StandingsQuery) models.StandingsQuery {
+				q.Page.Token = "not a token"
+				return q
+			},
+		},
+		{
+			name: "other rules",
+			query: func(q models.StandingsQuery) models.StandingsQuery {
+				q.Rules = models.StandingsRules{Win: 2, Draw: 1}
+				return q
+			},
+		},
+		{
+			name: "other group",
+			query: func(q models.StandingsQuery) models.StandingsQuery {
+				q.Group = 1
+				return q
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := su.Standings(context.Background(), tt.query(query))
+			if !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("Standings() error = %v, want invalid argument", err)
+			}
+		})
+	}
+}
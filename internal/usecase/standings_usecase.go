@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"hash/fnv"
+	"math"
+	"slices"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+)
+
+var tiebreaks = []models.Tiebreak{
+	models.TiebreakHeadToHead,
+	models.TiebreakBuchholz,
+	models.TiebreakScoreDiff,
+	models.TiebreakRandom,
+}
+
+type standingsUseCase struct {
+	tournamentsRepository repository.TournamentsRepository
+	gamesRepository       repository.GamesRepository
+	resultsRepository     repository.ResultsRepository
+	contextTimeout        time.Duration
+}
+
+func NewStandingsUseCase(tournamentsRepository repository.TournamentsRepository, gamesRepository repository.GamesRepository, resultsRepository repository.ResultsRepository, timeout time.Duration) usecase.StandingsUseCase {
+	return &standingsUseCase{
+		tournamentsRepository: tournamentsRepository,
+		gamesRepository:       gamesRepository,
+		resultsRepository:     resultsRepository,
+		contextTimeout:        timeout,
+	}
+}
+
+func (su *standingsUseCase) Standings(ctx context.Context, q models.StandingsQuery) (models.StandingsPage, error) {
+	ctx, cancel := context.WithTimeout(ctx, su.contextTimeout)
+	defer cancel()
+
+	if err := validateStandingsQuery(q); err != nil {
+		return models.StandingsPage{}, err
+	}
+	offset, err := decodeStandingsToken(q)
+	if err != nil {
+		return models.StandingsPage{}, err
+	}
+
+	if _, err := su.tournamentsRepository.FetchById(ctx, q.TournamentID); err != nil {
+		return models.StandingsPage{}, err
+	}
+
+	games, err := su.gamesRepository.FetchByTournament(ctx, q.TournamentID)
+	if err != nil {
+		return models.StandingsPage{}, err
+	}
+	if q.Group > 0 {
+		games = slices.DeleteFunc(games, func(g models.Game) bool {
+			return g.Bracket != models.BracketGroup || g.Group != q.Group
+		})
+	}
+
+	results, err := su.resultsRepository.FetchByTournament(ctx, q.TournamentID)
+	if err != nil {
+		return models.StandingsPage{}, err
+	}
+	byGame := make(map[uuid.UUID]models.Result, len(results))
+	for _, r := range results {
+		byGame[r.GameID] = r
+	}
+
+	rows := Standings(games, byGame, q.Rules, q.TournamentID)
+
+	page := models.StandingsPage{Total: len(rows)}
+	end := min(offset+q.Page.Limit(), len(rows))
+	if offset < end {
+		page.Rows = rows[offset:end]
+	}
+	if end < len(rows) {
+		page.NextPageToken = encodeStandingsToken(q, end)
+	}
+	return page, nil
+}
+
+func validateStandingsQuery(q models.StandingsQuery) error {
+	var v validator
+	v.check(q.Group >= 0, "group", "must not be negative")
+	v.check(finite(q.Rules.Win), "rules.win", "must be a finite number")
+	v.check(finite(q.Rules.Draw), "rules.draw", "must be a finite number")
+	v.check(finite(q.Rules.Loss), "rules.loss", "must be a finite number")
+	for i, t := range q.Rules.Tiebreaks {
+		v.check(slices.Contains(tiebreaks, t), fmt.Sprintf("rules.tiebreaks[%d]", i), "must be one of %v", tiebreaks)
+	}
+	return v.err()
+}
+
+func finite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// standingsToken is the row a page of a table starts at. Tables are computed
+// on every request, so the token remembers which table it was issued for.
+type standingsToken struct {
+	Table  uint64 `json:"t"`
+	Offset int    `json:"o"`
+}
+
+// tableOf tells tables apart: the same tournament, group and rules give the
+// same table.
+func tableOf(q models.StandingsQuery) uint64 {
+	raw, _ := json.Marshal(struct {
+		TournamentID uuid.UUID
+		Group        int
+		Rules        models.StandingsRules
+	}{q.TournamentID, q.Group, q.Rules})
+	h := fnv.New64a()
+	h.Write(raw)
+	return h.Sum64()
+}
+
+func encodeStandingsToken(q models.StandingsQuery, offset int) string {
+	raw, _ := json.Marshal(standingsToken{Table: tableOf(q), Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeStandingsToken(q models.StandingsQuery) (int, error) {
+	if q.Page.Token == "" {
+		return 0, nil
+	}
+
+	var t standingsToken
+	raw, err := base64.RawURLEncoding.DecodeString(q.Page.Token)
+	if err != nil {
+		return 0, domain.InvalidArgument("Malformed page token")
+	}
+	if err := json.Unmarshal(raw, &t); err != nil || t.Offset < 0 {
+		return 0, domain.InvalidArgument("Malformed page token")
+	}
+	if t.Table != tableOf(q) {
+		return 0, domain.InvalidArgument("Page token was issued for a different table")
+	}
+	return t.Offset, nil
+}
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"sort"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+)
+
+// SwissRounds is the number of rounds a Swiss tournament plays: the
+// configured value or enough rounds to leave a single unbeaten participant.
+func SwissRounds(t models.Tournament, participants int) int {
+	if t.SwissRounds > 0 {
+		return t.SwissRounds
+	}
+	rounds := 0
+	for size := 1; size < participants; size *= 2 {
+		rounds++
+	}
+	return rounds
+}
+
+// SwissFirstRound pairs the top half of the seeds against the bottom half,
+// 1 against n/2+1 and so on. With an odd field the lowest seed gets the bye.
+func SwissFirstRound(t models.Tournament, seeds []uuid.UUID) ([]models.Game, error) {
+	if len(seeds) < 2 {
+		return nil, domain.InvalidArgument("swiss needs at least 2 participants, got %d", len(seeds))
+	}
+
+	var games []models.Game
+	if len(seeds)%2 == 1 {
+		games = append(games, swissGame(t, 1, len(seeds)/2, seeds[len(seeds)-1]))
+		seeds = seeds[:len(seeds)-1]
+	}
+
+	half := len(seeds) / 2
+	for i := 0; i < half; i++ {
+		games = append(games, swissGame(t, 1, i, seeds[i], seeds[half+i]))
+	}
+
+	return games, nil
+}
+
+// swissRecord is what the pairing needs to know about a participant.
+type swissRecord struct {
+	standing  models.SwissStanding
+	opponents []uuid.UUID
+	// earned holds the points taken from each opponent for Sonneborn-Berger.
+	earned []float64
+	hadBye bool
+	// order keeps first round seeding as the last tiebreak.
+	order int
+}
+
+func swissRecords(games []models.Game, results map[uuid.UUID]models.Result) map[uuid.UUID]*swissRecord {
+	seeds := swissSeeds(games)
+	records := make(map[uuid.UUID]*swissRecord)
+	record := func(id uuid.UUID) *swissRecord {
+		if records[id] == nil {
+			order, seeded := seeds[id]
+			if !seeded {
+				order = len(seeds) + len(records)
+			}
+			records[id] = &swissRecord{standing: models.SwissStanding{ParticipantID: id}, order: order}
+		}
+		return records[id]
+	}
+
+	for _, g := range games {
+		if len(g.Participants) == 1 {
+			r := record(g.Participants[0].ParticipantID)
+			r.hadBye = true
+			r.standing.Score++
+			continue
+		}
+		for _, p := range g.Participants {
+			r := record(p.ParticipantID)
+			result, decided := results[g.GameID]
+			var earned float64
+			if decided {
+				earned = points(result, p.ParticipantID)
+			}
+			for _, o := range g.Participants {
+				if o.ParticipantID == p.ParticipantID {
+					continue
+				}
+				r.opponents = append(r.opponents, o.ParticipantID)
+				r.earned = append(r.earned, earned)
+			}
+			r.standing.Score += earned
+		}
+	}
+
+	for _, r := range records {
+		for i, o := range r.opponents {
+			r.standing.Buchholz += records[o].standing.Score
+			r.standing.SonnebornBerger += r.earned[i] * records[o].standing.Score
+		}
+	}
+
+	return records
+}
+
+// swissSeeds recovers the seeds SwissFirstRound paired: the top half holds
+// slot 0 of the games in position order, the bottom half slot 1 and the
+// lowest seed the bye.
+func swissSeeds(games []models.Game) map[uuid.UUID]int {
+	pairs := 0
+	for _, g := range games {
+		if g.Round == 1 && len(g.Participants) == 2 {
+			pairs++
+		}
+	}
+
+	seeds := make(map[uuid.UUID]int, 2*pairs+1)
+	for _, g := range games {
+		if g.Round != 1 {
+			continue
+		}
+		for _, p := range g.Participants {
+			switch {
+			case len(g.Participants) == 1:
+				seeds[p.ParticipantID] = 2 * pairs
+			case p.Slot == 0:
+				seeds[p.ParticipantID] = g.Position
+			default:
+				seeds[p.ParticipantID] = pairs + g.Position
+			}
+		}
+	}
+	return seeds
+}
+
+func rankSwiss(records map[uuid.UUID]*swissRecord) []*swissRecord {
+	ranked := make([]*swissRecord, 0, len(records))
+	for _, r := range records {
+		ranked = append(ranked, r)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i].standing, ranked[j].standing
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Buchholz != b.Buchholz {
+			return a.Buchholz > b.Buchholz
+		}
+		if a.SonnebornBerger != b.SonnebornBerger {
+			return a.SonnebornBerger > b.SonnebornBerger
+		}
+		return ranked[i].order < ranked[j].order
+	})
+	return ranked
+}
+
+// SwissStandings ranks participants by score, then Buchholz (the sum of
+// the opponents' scores), then Sonneborn-Berger (the scores of the opponents
+// beaten plus half the scores of the opponents drawn).
+func SwissStandings(games []models.Game, results map[uuid.UUID]models.Result) []models.SwissStanding {
+	ranked := rankSwiss(swissRecords(games, results))
+	standings := make([]models.SwissStanding, 0, len(ranked))
+	for _, r := range ranked {
+		standings = append(standings, r.standing)
+	}
+	return standings
+}
+
+// SwissNextRound pairs the given round from the games played so far. The
+// bye goes to the lowest ranked participant who has not had one yet, then
+// participants are paired down the table with the closest scored opponent
+// they have not met. Rematches are only allowed when no pairing without
+// them exists.
+func SwissNextRound(t models.Tournament, games []models.Game, results map[uuid.UUID]models.Result, round int) ([]models.Game, error) {
+	ranked := rankSwiss(swissRecords(games, results))
+	if len(ranked) < 2 {
+		return nil, domain.InvalidArgument("swiss needs at least 2 participants, got %d", len(ranked))
+	}
+
+	var next []models.Game
+	if len(ranked)%2 == 1 {
+		bye := len(ranked) - 1
+		for i := len(ranked) - 1; i >= 0; i-- {
+			if !ranked[i].hadBye {
+				bye = i
+				break
+			}
+		}
+		next = append(next, swissGame(t, round, len(ranked)/2, ranked[bye].standing.ParticipantID))
+		ranked = append(ranked[:bye:bye], ranked[bye+1:]...)
+	}
+
+	budget := maxSwissPairingSteps
+	pairs, ok := pairSwiss(ranked, false, &budget)
+	if !ok {
+		budget = maxSwissPairingSteps
+		pairs, _ = pairSwiss(ranked, true, &budget)
+	}
+
+	for i, pair := range pairs {
+		next = append(next, swissGame(t, round, i, pair[0].standing.ParticipantID, pair[1].standing.ParticipantID))
+	}
+
+	return next, nil
+}
+
+// maxSwissPairingSteps bounds the search for a pairing without rematches,
+// backtracking is exponential in the worst case. Rounds that cannot be paired
+// within it allow rematches.
+const maxSwissPairingSteps = 100_000
+
+// pairSwiss pairs the first unpaired participant with the nearest one below
+// it in the table, backtracking when the rest cannot be paired. Every pair
+// tried uses up a step of the budget, the search gives up once it is spent.
+func pairSwiss(ranked []*swissRecord, rematches bool, budget *int) ([][2]*swissRecord, bool) {
+	if len(ranked) == 0 {
+		return nil, true
+	}
+
+	top := ranked[0]
+	for i := 1; i < len(ranked); i++ {
+		if !rematches && contains(top.opponents, ranked[i].standing.ParticipantID) {
+			continue
+		}
+		if *budget <= 0 {
+			return nil, false
+		}
+		*budget--
+
+		rest := make([]*swissRecord, 0, len(ranked)-2)
+		rest = append(rest, ranked[1:i]...)
+		rest = append(rest, ranked[i+1:]...)
+
+		if pairs, ok := pairSwiss(rest, rematches, budget); ok {
+			return append([][2]*swissRecord{{top, ranked[i]}}, pairs...), true
+		}
+	}
+
+	return nil, false
+}
+
+func contains(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func swissGame(t models.Tournament, round, position int, participants ...uuid.UUID) models.Game {
+	g := newBracketGame(t, models.BracketSwiss, round, position)
+	g.GameStart = t.StartsAt.Add(time.Duration(round-1) * t.SlotLength)
+	for slot, p := range participants {
+		g.Participants = append(g.Participants, models.GameParticipant{ParticipantID: p, Slot: slot})
+	}
+	return g
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+	"testing"
+	"tournaments-core/internal/domain/models"
+)
+
+// swissHistory collects the games of a Swiss tournament with their results.
+type swissHistory struct {
+	t       models.Tournament
+	games   []models.Game
+	results map[uuid.UUID]models.Result
+}
+
+func newSwissHistory() *swissHistory {
+	return &swissHistory{t: testTournament(models.FormatSwiss), results: make(map[uuid.UUID]models.Result)}
+}
+
+// won records a game of the round in which winner beat loser.
+func (h *swissHistory) won(round int, winner, loser uuid.UUID) {
+	g := swissGame(h.t, round, len(h.games), winner, loser)
+	h.games = append(h.games, g)
+	h.results[g.GameID] = models.Result{
+		GameID:   g.GameID,
+		WinnerID: winner,
+		Outcome:  models.OutcomeWin,
+		Placements: []models.Placement{
+			{ParticipantID: winner, Place: 1},
+			{ParticipantID: loser, Place: 2},
+		},
+	}
+}
+
+func (h *swissHistory) bye(round int, participant uuid.UUID) {
+	h.games = append(h.games, swissGame(h.t, round, len(h.games), participant))
+}
+
+func TestSwissNextRound(t *testing.T) {
+	p := testSeeds(5)
+
+	tests := []struct {
+		name    string
+		history func(h *swissHistory)
+		round   int
+		pairs   [][2]uuid.UUID
+		bye     uuid.UUID
+	}{
+		{
+			name: "winners meet winners",
+			history: func(h *swissHistory) {
+				h.won(1, p[0], p[2])
+				h.won(1, p[1], p[3])
+			},
+			round: 2,
+			pairs: [][2]uuid.UUID{{p[0], p[1]}, {p[2], p[3]}},
+		},
+		{
+			// The leader has met both participants on one point, so it
+			// drops down to the last one instead of a rematch.
+			name: "leader avoids a rematch",
+			history: func(h *swissHistory) {
+				h.won(1, p[0], p[2])
+				h.won(1, p[1], p[3])
+				h.won(2, p[0], p[1])
+				h.won(2, p[2], p[3])
+			},
+			round: 3,
+			pairs: [][2]uuid.UUID{{p[0], p[3]}, {p[1], p[2]}},
+		},
+		{
+			// The lowest seed had the bye in round one, so it passes to the
+			// lowest ranked participant who has not had one.
+			name: "bye moves up the table",
+			history: func(h *swissHistory) {
+				h.won(1, p[0], p[2])
+				h.won(1, p[1], p[3])
+				h.bye(1, p[4])
+			},
+			round: 2,
+			pairs: [][2]uuid.UUID{{p[0], p[1]}, {p[4], p[2]}},
+			bye:   p[3],
+		},
+		{
+			// Everyone has met everyone, rematches are the only pairing left.
+			name: "rematches when no other pairing exists",
+			history: func(h *swissHistory) {
+				h.won(1, p[0], p[2])
+				h.won(1, p[1], p[3])
+				h.won(2, p[0], p[1])
+				h.won(2, p[2], p[3])
+				h.won(3, p[0], p[3])
+				h.won(3, p[1], p[2])
+			},
+			round: 4,
+			pairs: [][2]uuid.UUID{{p[0], p[1]}, {p[2], p[3]}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newSwissHistory()
+			tt.history(h)
+
+			next, err := SwissNextRound(h.t, h.games, h.results, tt.round)
+			if err != nil {
+				t.Fatalf("SwissNextRound() error = %v", err)
+			}
+
+			var pairs [][2]uuid.UUID
+			var bye uuid.UUID
+			for _, g := range next {
+				if g.Round != tt.round || g.Bracket != models.BracketSwiss {
+					t.Errorf("game %d is in %s round %d, want swiss round %d", g.Position, g.Bracket, g.Round, tt.round)
+				}
+				if len(g.Participants) == 1 {
+					bye = g.Participants[0].ParticipantID
+					continue
+				}
+				pairs = append(pairs, pairing(g))
+			}
+
+			if len(pairs) != len(tt.pairs) {
+				t.Fatalf("got pairs %v, want %v", pairs, tt.pairs)
+			}
+			for i := range pairs {
+				if pairs[i] != tt.pairs[i] {
+					t.Errorf("game %d pairs %v, want %v", i, pairs[i], tt.pairs[i])
+				}
+			}
+			if bye != tt.bye {
+				t.Errorf("bye goes to %s, want %s", bye, tt.bye)
+			}
+		})
+	}
+}
+
+func TestPairSwissBudget(t *testing.T) {
+	p := testSeeds(4)
+	// The leader has met the next two, pairing it with the last one takes a
+	// step and pairing the two left over another.
+	ranked := func() []*swissRecord {
+		return []*swissRecord{
+			{standing: models.SwissStanding{ParticipantID: p[0]}, opponents: []uuid.UUID{p[1], p[2]}},
+			{standing: models.SwissStanding{ParticipantID: p[1]}, opponents: []uuid.UUID{p[0]}},
+			{standing: models.SwissStanding{ParticipantID: p[2]}, opponents: []uuid.UUID{p[0]}},
+			{standing: models.SwissStanding{ParticipantID: p[3]}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		rematches bool
+		budget    int
+		pairs     [][2]uuid.UUID
+		ok        bool
+		left      int
+	}{
+		{name: "no budget", budget: 0},
+		{name: "runs out after the first pair", budget: 1},
+		{name: "enough budget", budget: 2, pairs: [][2]uuid.UUID{{p[0], p[3]}, {p[1], p[2]}}, ok: true},
+		{name: "budget left over", budget: 10, pairs: [][2]uuid.UUID{{p[0], p[3]}, {p[1], p[2]}}, ok: true, left: 8},
+		{name: "rematches pair down the table", rematches: true, budget: 2, pairs: [][2]uuid.UUID{{p[0], p[1]}, {p[2], p[3]}}, ok: true},
+		{name: "rematches out of budget", rematches: true, budget: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := tt.budget
+			pairs, ok := pairSwiss(ranked(), tt.rematches, &budget)
+			if ok != tt.ok {
+				t.Fatalf("pairSwiss() ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+
+			if len(pairs) != len(tt.pairs) {
+				t.Fatalf("pairSwiss() = %d pairs, want %d", len(pairs), len(tt.pairs))
+			}
+			for i, pair := range pairs {
+				got := [2]uuid.UUID{pair[0].standing.ParticipantID, pair[1].standing.ParticipantID}
+				if got != tt.pairs[i] {
+					t.Errorf("pair %d = %v, want %v", i, got, tt.pairs[i])
+				}
+			}
+			if budget != tt.left {
+				t.Errorf("budget left = %d, want %d", budget, tt.left)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+)
+
+type tournamentsUseCase struct {
+	tournamentsRepository repository.TournamentsRepository
+	gamesRepository       repository.GamesRepository
+	resultsRepository     repository.ResultsRepository
+	grantsRepository      repository.TournamentGrantsRepository
+	transactor            repository.Transactor
+	access                tournamentAccess
+	contextTimeout        time.Duration
+}
+
+func NewTournamentsUseCase(tournamentsRepository repository.TournamentsRepository, gamesRepository repository.GamesRepository, resultsRepository repository.ResultsRepository, grantsRepository repository.TournamentGrantsRepository, transactor repository.Transactor, timeout time.Duration) usecase.TournamentsUseCase {
+	return &tournamentsUseCase{
+		tournamentsRepository: tournamentsRepository,
+		gamesRepository:       gamesRepository,
+		resultsRepository:     resultsRepository,
+		grantsRepository:      grantsRepository,
+		transactor:            transactor,
+		access:                tournamentAccess{grantsRepository},
+		contextTimeout:        timeout,
+	}
+}
+
+func (tu *tournamentsUseCase) FetchById(ctx context.Context, id uuid.UUID) (models.Tournament, error) {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+	return tu.tournamentsRepository.FetchById(ctx, id)
+}
+
+func (tu *tournamentsUseCase) Update(ctx context.Context, updated *models.Tournament) error {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+	if _, err := tu.access.check(ctx, tournamentOf(updated.TournamentID), organiserAccess...); err != nil {
+		return err
+	}
+
+	// Dates are checked against the ones kept when only one of them changes.
+	merged := *updated
+	if updated.StartsAt.IsZero() != updated.EndsAt.IsZero() {
+		current, err := tu.tournamentsRepository.FetchById(ctx, updated.TournamentID)
+		if err != nil {
+			return err
+		}
+		if merged.StartsAt.IsZero() {
+			merged.StartsAt = current.StartsAt
+		}
+		if merged.EndsAt.IsZero() {
+			merged.EndsAt = current.EndsAt
+		}
+	}
+	var v validator
+	validateTournamentFields(&v, &merged)
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	return tu.tournamentsRepository.Update(ctx, updated)
+}
+
+func (tu *tournamentsUseCase) DeleteById(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+	if _, err := tu.access.check(ctx, tournamentOf(id), models.AccessOwner); err != nil {
+		return err
+	}
+	return tu.tournamentsRepository.DeleteById(ctx, id)
+}
+
+func (tu *tournamentsUseCase) Create(ctx context.Context, t *models.Tournament) error {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+	if err := validateNewTournament(t); err != nil {
+		return err
+	}
+	if t.Status == "" {
+		t.Status = models.TournamentDraft
+	}
+
+	// Whoever creates a tournament owns it, the tournament is only written
+	// along with its owner.
+	return tu.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := tu.tournamentsRepository.Create(ctx, t); err != nil {
+			return err
+		}
+
+		principal, ok := domain.PrincipalFrom(ctx)
+		if !ok {
+			return nil
+		}
+		return tu.grantsRepository.Grant(ctx, &models.TournamentGrant{
+			TournamentID: t.TournamentID,
+			Subject:      principal.Subject,
+			Access:       models.AccessOwner,
+			GrantedBy:    principal.Subject,
+		})
+	})
+}
+
+func (tu *tournamentsUseCase) GenerateBracket(ctx context.Context, id uuid.UUID, seeds []uuid.UUID) ([]models.Game, error) {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+
+	tournament, err := tu.tournamentsRepository.FetchById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tu.access.check(ctx, tournamentOf(id), organiserAccess...); err != nil {
+		return nil, err
+	}
+
+	existing, err := tu.gamesRepository.FetchByTournament(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, domain.Conflict("tournament %s already has %d games", id, len(existing))
+	}
+
+	var games []models.Game
+	switch tournament.Format {
+	case models.FormatSingleElimination:
+		games, err = SingleEliminationBracket(tournament, seeds)
+	case models.FormatDoubleElimination:
+		games, err = DoubleEliminationBracket(tournament, seeds)
+	case models.FormatRoundRobin:
+		games, err = RoundRobinSchedule(tournament, seeds, 1)
+	case models.FormatGroupStage:
+		games, err = GroupStage(tournament, seeds)
+	case models.FormatSwiss:
+		games, err = SwissFirstRound(tournament, seeds)
+	default:
+		return nil, domain.InvalidArgument("format %q does not support bracket generation", tournament.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// A draft tournament is scheduled along with its games, a failed update
+	// leaves no games behind.
+	err = tu.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := tu.gamesRepository.CreateMany(ctx, games); err != nil {
+			return err
+		}
+		if tournament.Status != models.TournamentDraft {
+			return nil
+		}
+		return tu.tournamentsRepository.Update(ctx, &models.Tournament{
+			TournamentID: id,
+			Status:       models.TournamentScheduled,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return games, nil
+}
+
+func (tu *tournamentsUseCase) SwissStandings(ctx context.Context, id uuid.UUID) ([]models.SwissStanding, error) {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+
+	tournament, err := tu.tournamentsRepository.FetchById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tournament.Format != models.FormatSwiss {
+		return nil, domain.Conflict("tournament %s is not a swiss tournament", id)
+	}
+
+	games, err := tu.gamesRepository.FetchByTournament(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := tu.resultsRepository.FetchByTournament(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	byGame := make(map[uuid.UUID]models.Result, len(results))
+	for _, r := range results {
+		byGame[r.GameID] = r
+	}
+
+	return SwissStandings(games, byGame), nil
+}
+
+func (tu *tournamentsUseCase) Grants(ctx context.Context, id uuid.UUID) ([]models.TournamentGrant, error) {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+
+	if _, err := tu.tournamentsRepository.FetchById(ctx, id); err != nil {
+		return nil, err
+	}
+	if _, err := tu.access.check(ctx, tournamentOf(id), officialAccess...); err != nil {
+		return nil, err
+	}
+	return tu.grantsRepository.FetchByTournament(ctx, id)
+}
+
+var grantAccesses = []models.GrantAccess{models.AccessOwner, models.AccessCoOrganiser, models.AccessReferee}
+
+// Grant gives a subject access to a tournament, replacing the access it had.
+// Owners grant any access, co-organisers only bring in referees.
+func (tu *tournamentsUseCase) Grant(ctx context.Context, g *models.TournamentGrant) error {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+
+	var v validator
+	v.check(g.Subject != "", "subject", "must not be empty")
+	v.check(slices.Contains(grantAccesses, g.Access), "access", "must be one of %v", grantAccesses)
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	if _, err := tu.tournamentsRepository.FetchById(ctx, g.TournamentID); err != nil {
+		return err
+	}
+	granter, err := tu.access.check(ctx, tournamentOf(g.TournamentID), organiserAccess...)
+	if err != nil {
+		return err
+	}
+	if granter.Access == models.AccessCoOrganiser {
+		if g.Access != models.AccessReferee {
+			return domain.PermissionDenied("co-organisers can only grant %s access", models.AccessReferee)
+		}
+		if err := tu.checkReplaceable(ctx, g.TournamentID, g.Subject); err != nil {
+			return err
+		}
+	}
+	if g.Access != models.AccessOwner {
+		if err := tu.keepOwner(ctx, g.TournamentID, g.Subject); err != nil {
+			return err
+		}
+	}
+
+	g.GrantedBy = ""
+	if principal, ok := domain.PrincipalFrom(ctx); ok {
+		g.GrantedBy = principal.Subject
+	}
+	return tu.grantsRepository.Grant(ctx, g)
+}
+
+// Revoke takes a subject's access to a tournament away. Owners revoke anyone
+// but the last owner, co-organisers only referees.
+func (tu *tournamentsUseCase) Revoke(ctx context.Context, id uuid.UUID, subject string) error {
+	ctx, cancel := context.WithTimeout(ctx, tu.contextTimeout)
+	defer cancel()
+
+	granter, err := tu.access.check(ctx, tournamentOf(id), organiserAccess...)
+	if err != nil {
+		return err
+	}
+	if granter.Access == models.AccessCoOrganiser {
+		if err := tu.checkReplaceable(ctx, id, subject); err != nil {
+			return err
+		}
+	}
+	if err := tu.keepOwner(ctx, id, subject); err != nil {
+		return err
+	}
+	return tu.grantsRepository.Revoke(ctx, id, subject)
+}
+
+// checkReplaceable makes sure a co-organiser only changes referee grants.
+func (tu *tournamentsUseCase) checkReplaceable(ctx context.Context, id uuid.UUID, subject string) error {
+	current, err := tu.grantsRepository.Fetch(ctx, id, subject)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current.Access != models.AccessReferee {
+		return domain.PermissionDenied("co-organisers can only change %s access", models.AccessReferee)
+	}
+	return nil
+}
+
+// keepOwner refuses to take owner access from the last owner of a tournament.
+func (tu *tournamentsUseCase) keepOwner(ctx context.Context, id uuid.UUID, subject string) error {
+	grants, err := tu.grantsRepository.FetchByTournament(ctx, id)
+	if err != nil {
+		return err
+	}
+	owners, isOwner := 0, false
+	for _, g := range grants {
+		if g.Access == models.AccessOwner {
+			owners++
+			isOwner = isOwner || g.Subject == subject
+		}
+	}
+	if isOwner && owners == 1 {
+		return &domain.Error{
+			Kind:     domain.ErrConflict,
+			Reason:   "LAST_OWNER",
+			Resource: "tournament",
+			Message:  subject + " is the last owner of tournament " + id.String(),
+		}
+	}
+	return nil
+}
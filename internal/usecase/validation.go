@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"math"
+	"slices"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"unicode/utf8"
+)
+
+const maxCommentLength = 1000
+
+// validator collects every broken rule of a request so that clients can fix
+// them all at once.
+type validator struct {
+	violations []domain.FieldViolation
+}
+
+func (v *validator) check(ok bool, field, format string, args ...any) {
+	if !ok {
+		v.violations = append(v.violations, domain.FieldViolation{
+			Field:       field,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return domain.Invalid(v.violations...)
+}
+
+// checkMask rejects fields of the mask that an update cannot write as an
+// invalid update_mask.
+func checkMask(mask models.UpdateMask, updatable ...string) error {
+	if err := mask.Check(updatable...); err != nil {
+		return domain.Invalid(domain.FieldViolation{Field: "update_mask", Description: err.Error()})
+	}
+	return nil
+}
+
+var tournamentFormats = []models.TournamentFormat{
+	models.FormatSingleElimination,
+	models.FormatDoubleElimination,
+	models.FormatRoundRobin,
+	models.FormatGroupStage,
+	models.FormatSwiss,
+}
+
+// validateNewTournament checks a tournament before it is created.
+func validateNewTournament(t *models.Tournament) error {
+	var v validator
+	v.check(t.Name != "", "name", "is required")
+	v.check(t.Format != "", "format", "is required")
+	v.check(!t.StartsAt.IsZero(), "starts_at", "is required")
+	v.check(!t.EndsAt.IsZero(), "ends_at", "is required")
+	validateTournamentFields(&v, t)
+	return v.err()
+}
+
+// validateTournamentFields checks the fields that are set, a tournament has to
+// end after it starts.
+func validateTournamentFields(v *validator, t *models.Tournament) {
+	v.check(t.Format == "" || slices.Contains(tournamentFormats, t.Format), "format", "must be one of %v", tournamentFormats)
+	v.check(t.StartsAt.IsZero() || t.EndsAt.IsZero() || t.StartsAt.Before(t.EndsAt), "ends_at", "must be after starts_at")
+}
+
+// validateNewGame checks a game before it is created, games are only created
+// ahead of their start.
+func validateNewGame(g *models.Game, now time.Time) error {
+	var v validator
+	v.check(!g.GameStart.IsZero(), "game_start", "is required")
+	v.check(g.GameStart.IsZero() || g.GameStart.After(now), "game_start", "must be in the future")
+	v.check(g.GameTypeID != uuid.Nil, "game_type_id", "is required")
+	validateGameFields(&v, g)
+	return v.err()
+}
+
+// validateGameUpdate checks the fields of the mask.
+func validateGameUpdate(g *models.Game, mask models.UpdateMask) error {
+	var v validator
+	if mask.Has("game_start") {
+		v.check(!g.GameStart.IsZero(), "game_start", "is required")
+	}
+	if mask.Has("game_type_id") {
+		v.check(g.GameTypeID != uuid.Nil, "game_type_id", "is required")
+	}
+	validateGameFields(&v, g)
+	return v.err()
+}
+
+func validateGameFields(v *validator, g *models.Game) {
+	v.check(g.Round >= 0, "round", "must not be negative")
+	for i, p := range g.Participants {
+		v.check(p.ParticipantID != uuid.Nil, fmt.Sprintf("participants[%d].participant_id", i), "is required")
+		v.check(p.Slot >= 0, fmt.Sprintf("participants[%d].slot", i), "must not be negative")
+	}
+}
+
+func validateResultFields(r *models.Result) error {
+	var v validator
+	v.check(r.GameID != uuid.Nil, "game_id", "is required")
+	v.check(utf8.RuneCountInString(r.Comment) <= maxCommentLength, "comment", "must be at most %d characters", maxCommentLength)
+	for i, p := range r.Placements {
+		v.check(p.ParticipantID != uuid.Nil, fmt.Sprintf("placements[%d].participant_id", i), "is required")
+		v.check(p.Place > 0, fmt.Sprintf("placements[%d].place", i), "must be positive")
+		v.check(!math.IsNaN(p.Score) && !math.IsInf(p.Score, 0), fmt.Sprintf("placements[%d].score", i), "must be a finite number")
+	}
+	return v.err()
+}
+
+// validateResult checks the fields of a result, that its game exists, is
+// live or finished and has no other final result.
+func (ru *resultsUseCase) validateResult(ctx context.Context, r *models.Result) error {
+	if err := validateResultFields(r); err != nil {
+		return err
+	}
+
+	game, err := ru.gamesRepository.FetchById(ctx, r.GameID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.Invalid(domain.FieldViolation{Field: "game_id", Description: "game does not exist"})
+	}
+	if err != nil {
+		return err
+	}
+
+	existing, err := ru.resultRepository.FetchByGameId(ctx, r.GameID)
+	if err == nil && existing.ResultID != r.ResultID {
+		return &domain.Error{
+			Kind:       domain.ErrAlreadyExists,
+			Reason:     "RESULT_EXISTS",
+			Resource:   "result",
+			Message:    fmt.Sprintf("game %s already has result %s", r.GameID, existing.ResultID),
+			Violations: []domain.FieldViolation{{Field: "game_id", Description: "game already has a result"}},
+		}
+	}
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	// A game cancelled by its own result keeps accepting edits of it.
+	recorded := err == nil && existing.ResultID == r.ResultID
+	if game.Status != models.GameLive && game.Status != models.GameFinished && !recorded {
+		return domain.Conflict("game %s is %s, results are recorded for live or finished games", game.GameID, game.Status)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/google/uuid"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"tournaments-core/internal/domain"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+	"tournaments-core/internal/domain/ports/usecase"
+)
+
+const (
+	secretBytes     = 32
+	minSecretLength = 16
+)
+
+type webhooksUseCase struct {
+	webhooksRepository repository.WebhooksRepository
+	access             tournamentAccess
+	contextTimeout     time.Duration
+}
+
+func NewWebhooksUseCase(webhooksRepository repository.WebhooksRepository, grantsRepository repository.TournamentGrantsRepository, timeout time.Duration) usecase.WebhooksUseCase {
+	return &webhooksUseCase{
+		webhooksRepository: webhooksRepository,
+		access:             tournamentAccess{grantsRepository},
+		contextTimeout:     timeout,
+	}
+}
+
+func (wu *webhooksUseCase) FetchById(ctx context.Context, id uuid.UUID) (models.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+	return wu.fetchOwned(ctx, id)
+}
+
+// FetchAll returns the webhooks of the caller, admins see every webhook.
+func (wu *webhooksUseCase) FetchAll(ctx context.Context) ([]models.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	webhooks, err := wu.webhooksRepository.FetchAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(webhooks, func(w models.Webhook) bool {
+		return checkWebhookOwner(ctx, w) != nil
+	}), nil
+}
+
+// Update keeps the stored secret when none is sent, unless the URL changes:
+// the new receiver has to be given the secret again.
+func (wu *webhooksUseCase) Update(ctx context.Context, updated *models.Webhook) error {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	current, err := wu.fetchOwned(ctx, updated.WebhookID)
+	if err != nil {
+		return err
+	}
+	if updated.Secret == "" {
+		if updated.URL != current.URL {
+			return domain.Invalid(domain.FieldViolation{Field: "secret", Description: "must be sent again when the url changes"})
+		}
+		updated.Secret = current.Secret
+	}
+	updated.CreatedBy = current.CreatedBy
+
+	if err := validateWebhook(updated); err != nil {
+		return err
+	}
+	if err := wu.checkScope(ctx, updated); err != nil {
+		return err
+	}
+
+	return wu.webhooksRepository.Update(ctx, updated)
+}
+
+func (wu *webhooksUseCase) DeleteById(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+	if _, err := wu.fetchOwned(ctx, id); err != nil {
+		return err
+	}
+	return wu.webhooksRepository.DeleteById(ctx, id)
+}
+
+func (wu *webhooksUseCase) Create(ctx context.Context, w *models.Webhook) error {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	if w.Secret == "" {
+		secret, err := newSecret()
+		if err != nil {
+			return err
+		}
+		w.Secret = secret
+	}
+	w.CreatedBy = subjectOf(ctx)
+
+	if err := validateWebhook(w); err != nil {
+		return err
+	}
+	if err := wu.checkScope(ctx, w); err != nil {
+		return err
+	}
+
+	return wu.webhooksRepository.Create(ctx, w)
+}
+
+func (wu *webhooksUseCase) ListDeliveries(ctx context.Context, filter models.DeliveriesFilter) (models.DeliveriesPage, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	if _, err := wu.fetchOwned(ctx, filter.WebhookID); err != nil {
+		return models.DeliveriesPage{}, err
+	}
+
+	return wu.webhooksRepository.ListDeliveries(ctx, filter)
+}
+
+func (wu *webhooksUseCase) Redeliver(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, wu.contextTimeout)
+	defer cancel()
+
+	d, err := wu.webhooksRepository.FetchDelivery(ctx, id)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	if _, err := wu.fetchOwned(ctx, d.WebhookID); err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	return wu.webhooksRepository.Redeliver(ctx, id)
+}
+
+// fetchOwned returns a webhook the caller manages.
+func (wu *webhooksUseCase) fetchOwned(ctx context.Context, id uuid.UUID) (models.Webhook, error) {
+	w, err := wu.webhooksRepository.FetchById(ctx, id)
+	if err != nil {
+		return models.Webhook{}, err
+	}
+	if err := checkWebhookOwner(ctx, w); err != nil {
+		return models.Webhook{}, err
+	}
+	return w, nil
+}
+
+// checkWebhookOwner lets the creator of a webhook and admins manage it. Work
+// without a caller is done by the service itself.
+func checkWebhookOwner(ctx context.Context, w models.Webhook) error {
+	principal, ok := domain.PrincipalFrom(ctx)
+	if !ok || principal.HasRole(models.RoleAdmin) || (w.CreatedBy != "" && w.CreatedBy == principal.Subject) {
+		return nil
+	}
+	return domain.PermissionDenied("%s does not manage webhook %s", principal.Subject, w.WebhookID)
+}
+
+// checkScope makes sure the caller may see the events a webhook subscribes
+// to: organisers only subscribe to tournaments they organise, only admins to
+// the events of every tournament.
+func (wu *webhooksUseCase) checkScope(ctx context.Context, w *models.Webhook) error {
+	principal, ok := domain.PrincipalFrom(ctx)
+	if !ok || principal.HasRole(models.RoleAdmin) {
+		return nil
+	}
+	if !w.TournamentID.Valid {
+		return domain.PermissionDenied("only admins subscribe to the events of every tournament")
+	}
+	_, err := wu.access.check(ctx, w.TournamentID, organiserAccess...)
+	return err
+}
+
+func newSecret() (string, error) {
+	raw := make([]byte, secretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// validateWebhook only accepts https URLs of public hosts, so that webhooks
+// cannot be pointed at the service's own network. Hosts given by name are
+// checked again when the sender connects to them.
+func validateWebhook(w *models.Webhook) error {
+	var v validator
+
+	u, err := url.Parse(w.URL)
+	v.check(err == nil && u.Scheme == "https" && u.Hostname() != "", "url", "must be an absolute https URL")
+	if err == nil && u.Hostname() != "" {
+		v.check(publicHost(u.Hostname()), "url", "must not point to a loopback or private host")
+	}
+	v.check(len(w.Secret) >= minSecretLength, "secret", "must be at least %d characters", minSecretLength)
+
+	for i, t := range w.EventTypes {
+		v.check(slices.Contains(models.WebhookEventTypes, t), fmt.Sprintf("event_types[%d]", i),
+			"must be one of %v", models.WebhookEventTypes)
+	}
+	for i, s := range w.GameStatuses {
+		v.check(validGameStatus(s), fmt.Sprintf("game_statuses[%d]", i), "is not a game status")
+	}
+
+	return v.err()
+}
+
+func publicHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return models.PublicWebhookAddr(ip)
+	}
+	return true
+}
+
+func validGameStatus(s models.GameStatus) bool {
+	switch s {
+	case models.GameScheduled, models.GameCheckIn, models.GameLive,
+		models.GameFinished, models.GameCancelled, models.GamePostponed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+// Dispatcher is an outbox sink that turns every event into a pending
+// delivery for each webhook it matches. An event relayed again is not
+// delivered twice.
+type Dispatcher struct {
+	webhooks repository.WebhooksRepository
+}
+
+func NewDispatcher(webhooks repository.WebhooksRepository) *Dispatcher {
+	return &Dispatcher{webhooks: webhooks}
+}
+
+func (d *Dispatcher) Deliver(ctx context.Context, e models.Event) error {
+	webhooks, err := d.webhooks.FetchAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("webhooks: failed to encode event: %w", err)
+	}
+
+	var deliveries []models.WebhookDelivery
+	for _, w := range webhooks {
+		if !w.Matches(e) {
+			continue
+		}
+		deliveries = append(deliveries, models.WebhookDelivery{
+			DeliveryID: uuid.New(),
+			WebhookID:  w.WebhookID,
+			Sequence:   e.Sequence,
+			EventType:  e.Name(),
+			Payload:    payload,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return d.webhooks.Enqueue(ctx, deliveries)
+}
@@ -0,0 +1,168 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+const (
+	batchSize      = 50
+	pollInterval   = time.Second
+	requestTimeout = 10 * time.Second
+	// claimLease must outlast sending a whole batch, otherwise another sender
+	// claims the same deliveries while they are being sent.
+	claimLease = 15 * time.Minute
+	// MaxAttempts is how often a delivery is tried before it goes to the
+	// dead-letter list.
+	MaxAttempts = 10
+	maxBackoff  = time.Hour
+	// errorBodyLimit caps how much of a failed response is kept.
+	errorBodyLimit = 512
+)
+
+// Sender posts pending deliveries to their webhooks. A delivery succeeds on
+// any 2xx response, failed ones are retried with exponential backoff and end
+// up dead after MaxAttempts.
+type Sender struct {
+	webhooks repository.WebhooksRepository
+	client   *http.Client
+}
+
+// NewSender uses client to post deliveries. Without one it uses a client that
+// refuses to connect to loopback and private addresses, also when a webhook's
+// host resolves to one.
+func NewSender(webhooks repository.WebhooksRepository, client *http.Client) *Sender {
+	if client == nil {
+		client = publicClient()
+	}
+	return &Sender{webhooks: webhooks, client: client}
+}
+
+func publicClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addr, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !models.PublicWebhookAddr(addr.Addr()) {
+				return fmt.Errorf("refusing to connect to non-public address %s", addr.Addr())
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: requestTimeout, Transport: transport}
+}
+
+// Run sends deliveries until ctx is done.
+func (s *Sender) Run(ctx context.Context) {
+	for {
+		n, err := s.SendDue(ctx)
+		if err != nil {
+			log.Printf("[WEBHOOKS]: %v", err)
+		}
+
+		// A full batch means more deliveries are likely due.
+		if err == nil && n == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// SendDue makes one attempt at every delivery that is due and returns how
+// many were attempted.
+func (s *Sender) SendDue(ctx context.Context) (int, error) {
+	deliveries, err := s.webhooks.ClaimDeliveries(ctx, batchSize, claimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range deliveries {
+		d := &deliveries[i]
+		s.attempt(ctx, d, time.Now())
+		if err := s.webhooks.SaveAttempt(ctx, d); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (s *Sender) attempt(ctx context.Context, d *models.WebhookDelivery, now time.Time) {
+	d.Attempts++
+
+	code, err := s.post(ctx, d, now)
+	d.ResponseStatus = code
+	if err == nil {
+		d.Status = models.DeliveryDelivered
+		d.LastError = ""
+		d.DeliveredAt = now
+		d.NextAttemptAt = now
+		return
+	}
+
+	d.LastError = err.Error()
+	if d.Attempts >= MaxAttempts {
+		d.Status = models.DeliveryDead
+		d.NextAttemptAt = now
+		return
+	}
+	d.Status = models.DeliveryPending
+	d.NextAttemptAt = now.Add(backoff(d.Attempts))
+}
+
+func (s *Sender) post(ctx context.Context, d *models.WebhookDelivery, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, d.DeliveryID.String())
+	req.Header.Set(HeaderEvent, d.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(d.Secret, now, d.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyLimit))
+		return resp.StatusCode, fmt.Errorf("responded %s: %s", resp.Status, strings.ToValidUTF8(string(body), ""))
+	}
+
+	return resp.StatusCode, nil
+}
+
+// backoff doubles the delay after every failed attempt, starting at a few
+// seconds.
+func backoff(attempts int) time.Duration {
+	if attempts >= 20 {
+		return maxBackoff
+	}
+	return min(5*time.Second<<(attempts-1), maxBackoff)
+}
@@ -0,0 +1,203 @@
+package webhooks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"tournaments-core/internal/domain/models"
+	"tournaments-core/internal/domain/ports/repository"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// deliveriesRepository hands out its pending deliveries on every claim and
+// keeps the saved attempts.
+type deliveriesRepository struct {
+	repository.WebhooksRepository
+
+	mu         sync.Mutex
+	deliveries []models.WebhookDelivery
+}
+
+func (r *deliveriesRepository) ClaimDeliveries(_ context.Context, limit int, _ time.Duration) ([]models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var claimed []models.WebhookDelivery
+	for _, d := range r.deliveries {
+		if d.Status == models.DeliveryPending && len(claimed) < limit {
+			claimed = append(claimed, d)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *deliveriesRepository) SaveAttempt(_ context.Context, d *models.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.deliveries {
+		if r.deliveries[i].DeliveryID == d.DeliveryID {
+			r.deliveries[i] = *d
+		}
+	}
+	return nil
+}
+
+func (r *deliveriesRepository) delivery(t *testing.T) models.WebhookDelivery {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.deliveries) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(r.deliveries))
+	}
+	return r.deliveries[0]
+}
+
+func pendingDelivery(url string, attempts int) models.WebhookDelivery {
+	return models.WebhookDelivery{
+		DeliveryID: uuid.New(),
+		WebhookID:  uuid.New(),
+		Sequence:   42,
+		EventType:  "result.created",
+		Payload:    []byte(`{"sequence":42}`),
+		Status:     models.DeliveryPending,
+		Attempts:   attempts,
+		URL:        url,
+		Secret:     testSecret,
+	}
+}
+
+func TestSenderSignsDeliveries(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	requests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{header: r.Header.Clone(), body: body}
+	}))
+	defer server.Close()
+
+	repo := &deliveriesRepository{deliveries: []models.WebhookDelivery{pendingDelivery(server.URL, 0)}}
+	sent := repo.delivery(t)
+
+	if n, err := NewSender(repo, server.Client()).SendDue(context.Background()); err != nil || n != 1 {
+		t.Fatalf("SendDue() = %d, %v, want 1, nil", n, err)
+	}
+
+	r := <-requests
+	if got := r.header.Get(HeaderDelivery); got != sent.DeliveryID.String() {
+		t.Errorf("%s = %q, want %q", HeaderDelivery, got, sent.DeliveryID)
+	}
+	if got := r.header.Get(HeaderEvent); got != sent.EventType {
+		t.Errorf("%s = %q, want %q", HeaderEvent, got, sent.EventType)
+	}
+	if string(r.body) != string(sent.Payload) {
+		t.Errorf("body = %s, want %s", r.body, sent.Payload)
+	}
+
+	timestamp, signature := r.header.Get(HeaderTimestamp), r.header.Get(HeaderSignature)
+	if !Verify(testSecret, timestamp, signature, r.body, time.Minute) {
+		t.Errorf("Verify() rejected the signature %q of timestamp %q", signature, timestamp)
+	}
+	if Verify("another secret of the receiver", timestamp, signature, r.body, time.Minute) {
+		t.Error("Verify() accepted the signature with another secret")
+	}
+	if Verify(testSecret, timestamp, signature, []byte(`{"sequence":43}`), time.Minute) {
+		t.Error("Verify() accepted the signature of another body")
+	}
+
+	d := repo.delivery(t)
+	if d.Status != models.DeliveryDelivered || d.Attempts != 1 || d.ResponseStatus != http.StatusOK || d.LastError != "" {
+		t.Errorf("delivery = %s after %d attempts, status %d, error %q, want delivered after 1, status 200",
+			d.Status, d.Attempts, d.ResponseStatus, d.LastError)
+	}
+}
+
+func TestSenderRetriesWithBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	repo := &deliveriesRepository{deliveries: []models.WebhookDelivery{pendingDelivery(server.URL, 0)}}
+	sender := NewSender(repo, server.Client())
+
+	want := []time.Duration{
+		5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, 80 * time.Second,
+		160 * time.Second, 320 * time.Second, 640 * time.Second, 1280 * time.Second,
+	}
+	for i, delay := range want {
+		before := time.Now()
+		if _, err := sender.SendDue(context.Background()); err != nil {
+			t.Fatalf("attempt %d: SendDue() error = %v", i+1, err)
+		}
+
+		d := repo.delivery(t)
+		if d.Status != models.DeliveryPending || d.Attempts != i+1 {
+			t.Fatalf("attempt %d: delivery = %s after %d attempts, want pending", i+1, d.Status, d.Attempts)
+		}
+		if d.ResponseStatus != http.StatusServiceUnavailable || !strings.Contains(d.LastError, "unavailable") {
+			t.Errorf("attempt %d: status %d, error %q, want 503 with the response body", i+1, d.ResponseStatus, d.LastError)
+		}
+		if wait := d.NextAttemptAt.Sub(before); wait < delay || wait > delay+time.Second {
+			t.Errorf("attempt %d: next attempt in %v, want %v", i+1, wait, delay)
+		}
+	}
+}
+
+func TestSenderDeadLettersAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	repo := &deliveriesRepository{deliveries: []models.WebhookDelivery{pendingDelivery(server.URL, MaxAttempts-1)}}
+	sender := NewSender(repo, server.Client())
+
+	if _, err := sender.SendDue(context.Background()); err != nil {
+		t.Fatalf("SendDue() error = %v", err)
+	}
+	d := repo.delivery(t)
+	if d.Status != models.DeliveryDead || d.Attempts != MaxAttempts {
+		t.Fatalf("delivery = %s after %d attempts, want dead after %d", d.Status, d.Attempts, MaxAttempts)
+	}
+
+	// A dead delivery is not claimed again.
+	if n, err := sender.SendDue(context.Background()); err != nil || n != 0 {
+		t.Errorf("SendDue() after dead-lettering = %d, %v, want 0, nil", n, err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("webhook called %d times, want 1", n)
+	}
+}
+
+func TestSenderRefusesPrivateAddresses(t *testing.T) {
+	var called atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+	}))
+	defer server.Close()
+
+	repo := &deliveriesRepository{deliveries: []models.WebhookDelivery{pendingDelivery(server.URL, 0)}}
+	if _, err := NewSender(repo, nil).SendDue(context.Background()); err != nil {
+		t.Fatalf("SendDue() error = %v", err)
+	}
+
+	d := repo.delivery(t)
+	if called.Load() || d.Status != models.DeliveryPending || !strings.Contains(d.LastError, "non-public address") {
+		t.Errorf("delivery to %s = %s, error %q, want it refused", server.URL, d.Status, d.LastError)
+	}
+}